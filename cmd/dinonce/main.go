@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"github.com/etherlabsio/healthcheck/v2"
 	"github.com/rs/zerolog"
+	"github.com/welthee/dinonce/v2/internal/admin"
 	"github.com/welthee/dinonce/v2/internal/ticket"
 	"github.com/welthee/dinonce/v2/internal/ticket/psql"
+	"github.com/welthee/dinonce/v2/internal/webhook"
+	webhookpsql "github.com/welthee/dinonce/v2/internal/webhook/psql"
 	"net/http"
 	"os"
 	"os/signal"
@@ -19,10 +22,13 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 	"github.com/welthee/dinonce/v2/internal/api"
+	"github.com/welthee/dinonce/v2/internal/rpc"
 )
 
 const ShutDownTimeout = 30 * time.Second
 
+const defaultHealthCheckPort = 5001
+
 type postgreSQLBackendConfig struct {
 	Host         string
 	Port         int
@@ -31,6 +37,13 @@ type postgreSQLBackendConfig struct {
 	DatabaseName string
 }
 
+type namespaceConfig struct {
+	Name                string
+	MaxLineageCount     int
+	MaxLeasedNonceCount int
+	ApiKeySha256        []string
+}
+
 const backendKindPostgres = "postgres"
 const postgresMigrationsDir = "file://./scripts/psql/migrations"
 
@@ -67,9 +80,37 @@ func main() {
 	zerolog.SetGlobalLevel(logLevel)
 	zerolog.DefaultContextLogger = &log.Logger
 
+	viper.SetDefault("api.port", api.DefaultPort)
+	viper.SetDefault("grpc.port", rpc.DefaultPort)
+	viper.SetDefault("healthCheck.port", defaultHealthCheckPort)
+	viper.SetDefault("admin.port", admin.DefaultPort)
+
+	var namespaceCfgs []namespaceConfig
+	if err := viper.UnmarshalKey("namespaces", &namespaceCfgs); err != nil {
+		log.Fatal().Err(err).Msg("can not read namespaces")
+	}
+
+	var namespaces []ticket.Namespace
+	credentials := make(api.NamespaceCredentials)
+	for _, nsCfg := range namespaceCfgs {
+		namespaces = append(namespaces, ticket.Namespace{
+			Name:                nsCfg.Name,
+			MaxLineageCount:     nsCfg.MaxLineageCount,
+			MaxLeasedNonceCount: nsCfg.MaxLeasedNonceCount,
+		})
+		credentials[nsCfg.Name] = nsCfg.ApiKeySha256
+	}
+
 	healthCheckers := make(map[string]healthcheck.CheckerFunc)
 
+	// event streams are woken by one listener for the notifications of new lineage events
+	events := ticket.NewEventSubscriptions()
+	eventsCtx, stopEvents := context.WithCancel(log.Logger.WithContext(context.Background()))
+	defer stopEvents()
+
 	var svc ticket.Servicer
+	var administrator ticket.Administrator
+	var webhooks webhook.Store
 	switch viper.GetString("backendKind") {
 	case backendKindPostgres:
 		{
@@ -111,12 +152,33 @@ func main() {
 				return db.PingContext(ctx)
 			}
 
-			svc = psql.NewServicer(db)
+			go func() {
+				if err := psql.ListenLineageEvents(eventsCtx, psqlConnectionString, db, events); err != nil {
+					log.Fatal().Err(err).Msg("can not listen for lineage events")
+				}
+			}()
+
+			svc = psql.NewServicer(db, events, namespaces...)
+			administrator = psql.NewAdministrator(db)
+			webhooks = webhookpsql.NewStore(db)
 		}
 
 		log.Info().Msg("starting ticketing service")
 
-		apiHandler := api.NewHandler(svc)
+		apiHandler := api.NewHandler(svc, credentials, viper.GetInt("api.port"))
+
+		// webhooks are not delivered to internal addresses unless their network is allowed
+		allowedNetworks, err := webhook.ParseNetworks(viper.GetStringSlice("webhooks.allowedNetworks"))
+		if err != nil {
+			log.Fatal().Err(err).Msg("can not read webhooks.allowedNetworks")
+		}
+
+		dispatcherCtx, stopDispatcher := context.WithCancel(log.Logger.WithContext(context.Background()))
+		dispatcherDone := make(chan struct{})
+		go func() {
+			webhook.NewDispatcher(webhooks, allowedNetworks...).Run(dispatcherCtx)
+			close(dispatcherDone)
+		}()
 
 		go func() {
 			if err := apiHandler.Start(); err != nil && err != http.ErrServerClosed {
@@ -125,6 +187,30 @@ func main() {
 			log.Info().Msg("API shut down")
 		}()
 
+		rpcServer := rpc.NewServer(svc, credentials, viper.GetInt("grpc.port"))
+
+		go func() {
+			if err := rpcServer.Start(); err != nil {
+				log.Fatal().Err(err).Msg("can not start gRPC API")
+			}
+			log.Info().Msg("gRPC API shut down")
+		}()
+
+		// the admin API is only served if it has API keys
+		var adminHandler *admin.Handler
+		if adminKeys := viper.GetStringSlice("admin.apiKeySha256"); len(adminKeys) > 0 {
+			adminHandler = admin.NewHandler(administrator, webhooks, adminKeys, viper.GetInt("admin.port"))
+
+			go func() {
+				if err := adminHandler.Start(); err != nil && err != http.ErrServerClosed {
+					log.Fatal().Err(err).Msg("can not start admin API")
+				}
+				log.Info().Msg("admin API shut down")
+			}()
+		} else {
+			log.Info().Msg("admin API disabled, it has no API keys configured")
+		}
+
 		go func() {
 			var opts []healthcheck.Option
 			for k, v := range healthCheckers {
@@ -132,7 +218,8 @@ func main() {
 			}
 			opts = append(opts, healthcheck.WithTimeout(5*time.Second))
 
-			if err := http.ListenAndServe(":5001", healthcheck.Handler(opts...)); err != nil &&
+			addr := fmt.Sprintf(":%d", viper.GetInt("healthCheck.port"))
+			if err := http.ListenAndServe(addr, healthcheck.Handler(opts...)); err != nil &&
 				err != http.ErrServerClosed {
 
 				log.Fatal().Err(err).Msg("can not start healthcheck handler")
@@ -150,6 +237,20 @@ func main() {
 		if err := apiHandler.Stop(ctx); err != nil {
 			log.Fatal().Err(err).Msg("error on graceful shutdown of API")
 		}
+
+		if err := rpcServer.Stop(ctx); err != nil {
+			log.Fatal().Err(err).Msg("error on graceful shutdown of gRPC API")
+		}
+
+		if adminHandler != nil {
+			if err := adminHandler.Stop(ctx); err != nil {
+				log.Fatal().Err(err).Msg("error on graceful shutdown of admin API")
+			}
+		}
+
+		stopEvents()
+		stopDispatcher()
+		<-dispatcherDone
 		log.Info().Msg("stopped ticketing service")
 	}
 }
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// envConfigFile overrides the default location of the config file.
+const envConfigFile = "DINONCECTL_CONFIG"
+
+// profile addresses a dinonce server.
+type profile struct {
+	Server    string `yaml:"server" json:"server"`
+	Namespace string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	ApiKey    string `yaml:"apiKey,omitempty" json:"-"`
+	Actor     string `yaml:"actor,omitempty" json:"actor,omitempty"`
+}
+
+type config struct {
+	CurrentProfile string             `yaml:"currentProfile,omitempty"`
+	Profiles       map[string]profile `yaml:"profiles,omitempty"`
+}
+
+func configPath() (string, error) {
+	if flags.configFile != "" {
+		return flags.configFile, nil
+	}
+	if path := os.Getenv(envConfigFile); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".dinonce", "dinoncectl.yaml"), nil
+}
+
+// loadConfig reads the config file, a missing file is an empty config.
+func loadConfig() (*config, error) {
+	path, err := configPath()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &config{Profiles: map[string]profile{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("can not parse config file %s: %w", path, err)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]profile{}
+	}
+
+	return cfg, nil
+}
+
+// save writes the config file, readable only by the user as profiles carry API keys.
+func (c *config) save() error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+// selectedProfile returns the named profile, or the current profile if name is empty. Without a current profile an
+// empty profile is returned, to be completed by flags.
+func (c *config) selectedProfile(name string) (profile, error) {
+	if name == "" {
+		name = c.CurrentProfile
+	}
+	if name == "" {
+		return profile{}, nil
+	}
+
+	p, ok := c.Profiles[name]
+	if !ok {
+		return profile{}, fmt.Errorf("no such profile %s", name)
+	}
+
+	return p, nil
+}
+
+func newConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the profiles of dinonce servers",
+	}
+
+	cmd.AddCommand(
+		newSetProfileCommand(),
+		newUseProfileCommand(),
+		newListProfilesCommand(),
+		newDeleteProfileCommand(),
+	)
+
+	return cmd
+}
+
+func newSetProfileCommand() *cobra.Command {
+	var p profile
+
+	cmd := &cobra.Command{
+		Use:   "set-profile NAME",
+		Short: "Create or update a profile, the first profile becomes the current one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			existing := cfg.Profiles[args[0]]
+			fl := cmd.Flags()
+			if fl.Changed("server") {
+				existing.Server = p.Server
+			}
+			if fl.Changed("namespace") {
+				existing.Namespace = p.Namespace
+			}
+			if fl.Changed("api-key") {
+				existing.ApiKey = p.ApiKey
+			}
+			if fl.Changed("actor") {
+				existing.Actor = p.Actor
+			}
+			if existing.Server == "" {
+				return fmt.Errorf("profile %s needs a server", args[0])
+			}
+
+			cfg.Profiles[args[0]] = existing
+			if cfg.CurrentProfile == "" {
+				cfg.CurrentProfile = args[0]
+			}
+
+			return cfg.save()
+		},
+	}
+
+	// these flags shadow the global flags of the same name, which override profiles instead of editing them
+	fl := cmd.Flags()
+	fl.StringVar(&p.Server, "server", "", "base URL of the dinonce server, e.g. http://localhost:5010")
+	fl.StringVarP(&p.Namespace, "namespace", "n", "", "namespace of the lineages")
+	fl.StringVar(&p.ApiKey, "api-key", "", "API key of the namespace")
+	fl.StringVar(&p.Actor, "actor", "", "actor ticket changes are attributed to")
+
+	return cmd
+}
+
+func newUseProfileCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-profile NAME",
+		Short: "Make a profile the current one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := cfg.Profiles[args[0]]; !ok {
+				return fmt.Errorf("no such profile %s", args[0])
+			}
+			cfg.CurrentProfile = args[0]
+
+			return cfg.save()
+		},
+	}
+}
+
+func newDeleteProfileCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-profile NAME",
+		Short: "Delete a profile",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := cfg.Profiles[args[0]]; !ok {
+				return fmt.Errorf("no such profile %s", args[0])
+			}
+			delete(cfg.Profiles, args[0])
+			if cfg.CurrentProfile == args[0] {
+				cfg.CurrentProfile = ""
+			}
+
+			return cfg.save()
+		},
+	}
+}
+
+func newListProfilesCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-profiles",
+		Short: "List the profiles, API keys are not shown",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			names := make([]string, 0, len(cfg.Profiles))
+			for name := range cfg.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			return render(cmd, cfg.Profiles, func(w *tabwriter.Writer) {
+				row(w, "CURRENT", "NAME", "SERVER", "NAMESPACE", "ACTOR")
+				for _, name := range names {
+					p := cfg.Profiles[name]
+					current := ""
+					if name == cfg.CurrentProfile {
+						current = "*"
+					}
+					row(w, current, name, p.Server, p.Namespace, p.Actor)
+				}
+			})
+		},
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/welthee/dinonce/v2/pkg/client"
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+// tailReconnectDelay is how long tailing waits before reopening a stream which ended.
+const tailReconnectDelay = 1 * time.Second
+
+func newEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Read the events of lineages",
+	}
+
+	cmd.AddCommand(
+		newListEventsCommand(),
+		newTailEventsCommand(),
+	)
+
+	return cmd
+}
+
+func newListEventsCommand() *cobra.Command {
+	var after int64
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list LINEAGE_ID",
+		Short: "List the events of a lineage",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			params := &openapi.ListLineageEventsParams{}
+			fl := cmd.Flags()
+			if fl.Changed("after") {
+				params.After = &after
+			}
+			if fl.Changed("limit") {
+				params.Limit = &limit
+			}
+
+			resp, err := c.ListLineageEvents(cmd.Context(), args[0], params)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				row(w, eventColumns...)
+				for _, e := range resp.Events {
+					row(w, eventRow(e)...)
+				}
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.Int64Var(&after, "after", 0, "only events after this sequence number")
+	fl.IntVar(&limit, "limit", 0, "page size")
+
+	return cmd
+}
+
+func newTailEventsCommand() *cobra.Command {
+	var selector, extIdPrefix, lastEventId string
+
+	cmd := &cobra.Command{
+		Use:   "tail [LINEAGE_ID]",
+		Short: "Follow the events of a lineage, or of all lineages matching the filters, until interrupted",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			open := func(lastEventId string) (*client.EventStream, error) {
+				if len(args) == 1 {
+					return c.StreamLineageEvents(cmd.Context(), args[0], lastEventId)
+				}
+
+				params := &openapi.StreamLineagesEventsParams{}
+				if selector != "" {
+					params.LabelSelector = &selector
+				}
+				if extIdPrefix != "" {
+					params.ExtIdPrefix = &extIdPrefix
+				}
+				if lastEventId != "" {
+					params.LastEventID = &lastEventId
+				}
+
+				return c.StreamLineagesEvents(cmd.Context(), params)
+			}
+
+			return tail(cmd, open, lastEventId)
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&selector, "selector", "s", "", "label selector of the lineages to follow")
+	fl.StringVar(&extIdPrefix, "ext-id-prefix", "", "extId prefix of the lineages to follow")
+	fl.StringVar(&lastEventId, "last-event-id", "", "resume after this event id instead of starting with new events")
+
+	return cmd
+}
+
+// tail prints the events of the stream as they arrive, reopening it after the last event received whenever it ends.
+func tail(cmd *cobra.Command, open func(lastEventId string) (*client.EventStream, error), lastEventId string) error {
+	out := cmd.OutOrStdout()
+	enc := json.NewEncoder(out)
+	if flags.output == outputTable {
+		_, _ = fmt.Fprintf(out, tailRowFormat, eventColumns...)
+	}
+
+	for {
+		stream, err := open(lastEventId)
+		if err != nil {
+			return err
+		}
+
+		err = follow(stream, func(e *openapi.LineageEvent) error {
+			if flags.output == outputJson {
+				return enc.Encode(e)
+			}
+
+			_, err := fmt.Fprintf(out, tailRowFormat, eventRow(*e)...)
+			return err
+		})
+		lastEventId = stream.LastEventId()
+		_ = stream.Close()
+
+		if cmd.Context().Err() != nil {
+			return nil
+		}
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "event stream failed, reconnecting: %s\n", err)
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return nil
+		case <-time.After(tailReconnectDelay):
+		}
+	}
+}
+
+func follow(stream *client.EventStream, handle func(e *openapi.LineageEvent) error) error {
+	for {
+		e, err := stream.Next()
+		if err != nil {
+			return err
+		}
+
+		if err := handle(e); err != nil {
+			return err
+		}
+	}
+}
+
+// tailRowFormat aligns the columns of events printed one by one, which a tabwriter can not do.
+const tailRowFormat = "%-36v  %-6v  %-25v  %-20v  %-8v  %-12v  %v\n"
+
+var eventColumns = []interface{}{"LINEAGE ID", "SEQ", "TYPE", "EXT ID", "NONCE", "ACTOR", "CREATED AT"}
+
+func eventRow(e openapi.LineageEvent) []interface{} {
+	return []interface{}{e.LineageId, e.Seq, e.Type, valueOrDash(e.ExtId), valueOrDash(e.Nonce),
+		valueOrDash(e.Actor), e.CreatedAt.Format(time.RFC3339)}
+}
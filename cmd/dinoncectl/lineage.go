@@ -0,0 +1,330 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+func newLineageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lineage",
+		Aliases: []string{"lineages"},
+		Short:   "Manage lineages",
+	}
+
+	cmd.AddCommand(
+		newCreateLineageCommand(),
+		newGetLineageCommand(),
+		newListLineagesCommand(),
+		newUpdateLineageCommand(),
+		newLineageStatsCommand(),
+		newCloneLineageCommand(),
+	)
+
+	return cmd
+}
+
+func newCreateLineageCommand() *cobra.Command {
+	var req openapi.LineageCreationRequest
+	var labels []string
+	var startLeasingFrom int
+	var chainId int64
+	var address string
+
+	cmd := &cobra.Command{
+		Use:   "create EXT_ID",
+		Short: "Create a lineage, or get the existing one with the same configuration",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			req.ExtId = args[0]
+			if req.Labels, err = parseLabels(labels); err != nil {
+				return err
+			}
+			fl := cmd.Flags()
+			if fl.Changed("start-leasing-from") {
+				req.StartLeasingFrom = &startLeasingFrom
+			}
+			if fl.Changed("chain-id") {
+				req.ChainId = &chainId
+			}
+			if fl.Changed("address") {
+				req.Address = &address
+			}
+
+			resp, err := c.CreateLineage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				row(w, "ID", "EXT ID")
+				row(w, resp.Id, resp.ExtId)
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.IntVar(&req.MaxLeasedNonceCount, "max-leased", 0, "maximum number of leased tickets")
+	fl.StringArrayVarP(&labels, "label", "l", nil, "label as key=value, repeatable")
+	fl.IntVar(&startLeasingFrom, "start-leasing-from", 0, "first nonce to lease")
+	fl.Int64Var(&chainId, "chain-id", 0, "chain of the address the lineage issues nonces for")
+	fl.StringVar(&address, "address", "", "address the lineage issues nonces for")
+	_ = cmd.MarkFlagRequired("max-leased")
+	cmd.MarkFlagsRequiredTogether("chain-id", "address")
+
+	return cmd
+}
+
+func newGetLineageCommand() *cobra.Command {
+	var byExtId bool
+	var chainId int64
+	var address string
+
+	cmd := &cobra.Command{
+		Use:   "get [ID]",
+		Short: "Get a lineage by id, by extId or by chain address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			var resp *openapi.LineageGetResponse
+			switch {
+			case cmd.Flags().Changed("address"):
+				resp, err = c.GetLineageByAddress(cmd.Context(), chainId, address)
+			case len(args) != 1:
+				return fmt.Errorf("either a lineage or --chain-id and --address are required")
+			case byExtId:
+				resp, err = c.GetLineageByExtId(cmd.Context(), args[0])
+			default:
+				resp, err = c.GetLineage(cmd.Context(), args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				lineageRows(w, []openapi.LineageGetResponse{*resp})
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.BoolVar(&byExtId, "ext-id", false, "treat the argument as extId of the lineage")
+	fl.Int64Var(&chainId, "chain-id", 0, "chain of the address of the lineage")
+	fl.StringVar(&address, "address", "", "address of the lineage")
+	cmd.MarkFlagsRequiredTogether("chain-id", "address")
+
+	return cmd
+}
+
+func newListLineagesCommand() *cobra.Command {
+	var params openapi.ListLineagesParams
+	var selector, cursor string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the lineages of the namespace",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			fl := cmd.Flags()
+			if fl.Changed("selector") {
+				params.LabelSelector = &selector
+			}
+			if fl.Changed("limit") {
+				params.Limit = &limit
+			}
+			if fl.Changed("cursor") {
+				params.Cursor = &cursor
+			}
+
+			resp, err := c.ListLineages(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				lineageRows(w, resp.Lineages)
+				nextCursorRow(w, resp.NextCursor)
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&selector, "selector", "s", "", "label selector, e.g. chain=1,env!=staging")
+	fl.IntVar(&limit, "limit", 0, "page size")
+	fl.StringVar(&cursor, "cursor", "", "cursor of the page to list")
+
+	return cmd
+}
+
+func newUpdateLineageCommand() *cobra.Command {
+	var labels []string
+
+	cmd := &cobra.Command{
+		Use:   "update ID",
+		Short: "Replace the labels of a lineage",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			parsed, err := parseLabels(labels)
+			if err != nil {
+				return err
+			}
+			req := openapi.LineageUpdateRequest{Labels: openapi.Labels{}}
+			if parsed != nil {
+				req.Labels = *parsed
+			}
+
+			resp, err := c.UpdateLineage(cmd.Context(), args[0], req)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				lineageRows(w, []openapi.LineageGetResponse{*resp})
+			})
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&labels, "label", "l", nil, "label as key=value, repeatable")
+
+	return cmd
+}
+
+func newLineageStatsCommand() *cobra.Command {
+	var selector string
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show the summed counters of the lineages of the namespace",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			params := &openapi.GetLineageStatsParams{}
+			if cmd.Flags().Changed("selector") {
+				params.LabelSelector = &selector
+			}
+
+			resp, err := c.GetLineageStats(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				row(w, "LINEAGES", "LEASED", "RELEASED", "CLOSED", "MAX LEASED")
+				row(w, resp.LineageCount, resp.LeasedNonceCount, resp.ReleasedNonceCount, resp.ClosedNonceCount,
+					resp.MaxLeasedNonceCount)
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "selector", "s", "", "label selector, e.g. chain=1,env!=staging")
+
+	return cmd
+}
+
+func newCloneLineageCommand() *cobra.Command {
+	var labels []string
+	var includeTickets bool
+
+	cmd := &cobra.Command{
+		Use:   "clone ID EXT_ID",
+		Short: "Copy the counters, released nonces and optionally the tickets of a lineage into a new lineage",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			req := openapi.LineageCloneRequest{ExtId: args[1], IncludeTickets: &includeTickets}
+			if req.Labels, err = parseLabels(labels); err != nil {
+				return err
+			}
+
+			resp, err := c.CloneLineage(cmd.Context(), args[0], req)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				lineageRows(w, []openapi.LineageGetResponse{*resp})
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringArrayVarP(&labels, "label", "l", nil, "label of the clone as key=value, repeatable")
+	fl.BoolVar(&includeTickets, "include-tickets", false, "copy the tickets of the lineage as well")
+
+	return cmd
+}
+
+func lineageRows(w *tabwriter.Writer, lineages []openapi.LineageGetResponse) {
+	row(w, "ID", "EXT ID", "NEXT NONCE", "LEASED", "RELEASED", "CLOSED", "MAX LEASED", "LABELS")
+	for _, l := range lineages {
+		row(w, l.Id, l.ExtId, l.NextNonce, l.LeasedNonceCount, l.ReleasedNonceCount, l.ClosedNonceCount,
+			l.MaxLeasedNonceCount, formatLabels(l.Labels))
+	}
+}
+
+func nextCursorRow(w *tabwriter.Writer, cursor *string) {
+	if cursor != nil {
+		_, _ = fmt.Fprintf(w, "\nnext cursor: %s\n", *cursor)
+	}
+}
+
+// parseLabels parses key=value pairs, returning nil without any.
+func parseLabels(pairs []string) (*openapi.Labels, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	labels := openapi.Labels{}
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("label %q must be key=value", pair)
+		}
+		labels[key] = value
+	}
+
+	return &labels, nil
+}
+
+func formatLabels(labels openapi.Labels) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
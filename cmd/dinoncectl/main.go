@@ -0,0 +1,153 @@
+// Command dinoncectl operates dinonce servers from the command line.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/welthee/dinonce/v2/pkg/client"
+)
+
+const (
+	outputTable = "table"
+	outputJson  = "json"
+)
+
+// globalFlags are the flags every command accepts. Flags left empty fall back to the selected profile.
+type globalFlags struct {
+	configFile string
+	profile    string
+	server     string
+	namespace  string
+	apiKey     string
+	actor      string
+	output     string
+}
+
+var flags globalFlags
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := newRootCommand().ExecuteContext(ctx); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:          "dinoncectl",
+		Short:        "Operate dinonce ticketing servers",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if flags.output != outputTable && flags.output != outputJson {
+				return fmt.Errorf("output must be one of: %s, %s", outputTable, outputJson)
+			}
+
+			return nil
+		},
+	}
+
+	pf := root.PersistentFlags()
+	pf.StringVar(&flags.configFile, "config", "", "config file (default $HOME/.dinonce/dinoncectl.yaml)")
+	pf.StringVarP(&flags.profile, "profile", "p", "", "profile to use instead of the current profile")
+	pf.StringVar(&flags.server, "server", "", "base URL of the dinonce server, e.g. http://localhost:5010")
+	pf.StringVarP(&flags.namespace, "namespace", "n", "", "namespace of the lineages")
+	pf.StringVar(&flags.apiKey, "api-key", "", "API key of the namespace")
+	pf.StringVar(&flags.actor, "actor", "", "actor ticket changes are attributed to")
+	pf.StringVarP(&flags.output, "output", "o", outputTable, "output format: table or json")
+
+	root.AddCommand(
+		newConfigCommand(),
+		newLineageCommand(),
+		newTicketCommand(),
+		newNonceCommand(),
+		newEventsCommand(),
+	)
+
+	return root
+}
+
+// newClient creates a client of the server of the selected profile, overridden by the global flags.
+func newClient() (*client.Client, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := cfg.selectedProfile(flags.profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if flags.server != "" {
+		p.Server = flags.server
+	}
+	if flags.namespace != "" {
+		p.Namespace = flags.namespace
+	}
+	if flags.apiKey != "" {
+		p.ApiKey = flags.apiKey
+	}
+	if flags.actor != "" {
+		p.Actor = flags.actor
+	}
+
+	if p.Server == "" {
+		return nil, fmt.Errorf("no server configured, pass --server or create a profile with 'dinoncectl config set-profile'")
+	}
+
+	var opts []client.Option
+	if p.Namespace != "" {
+		opts = append(opts, client.WithNamespace(p.Namespace))
+	}
+	if p.ApiKey != "" {
+		opts = append(opts, client.WithAPIKey(p.ApiKey))
+	}
+	if p.Actor != "" {
+		opts = append(opts, client.WithActor(p.Actor))
+	}
+
+	return client.New(p.Server, opts...)
+}
+
+// render writes v as JSON, or as a table rendered by table.
+func render(cmd *cobra.Command, v interface{}, table func(w *tabwriter.Writer)) error {
+	out := cmd.OutOrStdout()
+
+	if flags.output == outputJson || table == nil {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(v)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	table(w)
+
+	return w.Flush()
+}
+
+func row(w *tabwriter.Writer, columns ...interface{}) {
+	for i, c := range columns {
+		if i > 0 {
+			_, _ = fmt.Fprint(w, "\t")
+		}
+		_, _ = fmt.Fprint(w, c)
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+func valueOrDash[T any](v *T) interface{} {
+	if v == nil {
+		return "-"
+	}
+
+	return *v
+}
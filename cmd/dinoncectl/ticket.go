@@ -0,0 +1,404 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+func newTicketCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ticket",
+		Aliases: []string{"tickets"},
+		Short:   "Lease, inspect, release and close tickets",
+	}
+
+	cmd.AddCommand(
+		newLeaseTicketsCommand(),
+		newGetTicketsCommand(),
+		newListTicketsCommand(),
+		newUpdateTicketsCommand("release", "Release tickets, their nonces are leased again",
+			openapi.TicketBulkUpdateItemStateReleased),
+		newUpdateTicketsCommand("close", "Close tickets, their nonces are used for good",
+			openapi.TicketBulkUpdateItemStateClosed),
+		newTransferTicketCommand(),
+		newTicketHistoryCommand(),
+	)
+
+	return cmd
+}
+
+func newLeaseTicketsCommand() *cobra.Command {
+	var contiguous, partial bool
+	var wait int
+	var idempotencyKey, ifMatch string
+
+	cmd := &cobra.Command{
+		Use:   "lease LINEAGE_ID EXT_ID...",
+		Short: "Lease tickets",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			params := &openapi.LeaseTicketParams{}
+			fl := cmd.Flags()
+			if fl.Changed("wait") {
+				params.Wait = &wait
+			}
+			if fl.Changed("idempotency-key") {
+				params.IdempotencyKey = &idempotencyKey
+			}
+			if fl.Changed("if-match") {
+				params.IfMatch = &ifMatch
+			}
+
+			resp, err := c.LeaseTickets(cmd.Context(), args[0], params, openapi.TicketLeaseRequest{
+				ExtIds:     args[1:],
+				Contiguous: &contiguous,
+				Partial:    &partial,
+			})
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				ticketRows(w, resp)
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.BoolVar(&contiguous, "contiguous", false, "lease one contiguous range of fresh nonces")
+	fl.BoolVar(&partial, "partial", false, "lease as many tickets as the lineage allows")
+	fl.IntVar(&wait, "wait", 0, "seconds to wait for a free ticket if the lineage is full")
+	fl.StringVar(&idempotencyKey, "idempotency-key", "", "idempotency key of the lease")
+	fl.StringVar(&ifMatch, "if-match", "", "only lease if the lineage still has this ETag")
+
+	return cmd
+}
+
+func newGetTicketsCommand() *cobra.Command {
+	var allOrNothing bool
+
+	cmd := &cobra.Command{
+		Use:   "get LINEAGE_ID EXT_ID...",
+		Short: "Get tickets",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			var resp *openapi.TicketLeaseResponse
+			if len(args) == 2 {
+				resp, err = c.GetTicket(cmd.Context(), args[0], args[1])
+			} else {
+				extIds := args[1:]
+				resp, err = c.GetTickets(cmd.Context(), args[0], &openapi.GetTicketsParams{
+					TicketExtIds: &extIds,
+					AllOrNothing: &allOrNothing,
+				})
+			}
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				ticketRows(w, resp)
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&allOrNothing, "all-or-nothing", false, "fail unless all of the tickets exist")
+
+	return cmd
+}
+
+func newListTicketsCommand() *cobra.Command {
+	var state, leasedAfter, leasedBefore, cursor string
+	var minNonce, maxNonce int64
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list LINEAGE_ID",
+		Short: "List the tickets of a lineage, oldest lease first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			params := &openapi.GetTicketsParams{}
+			fl := cmd.Flags()
+			if fl.Changed("state") {
+				s := openapi.GetTicketsParamsState(state)
+				params.State = &s
+			}
+			if params.LeasedAfter, err = parseTime(leasedAfter); err != nil {
+				return err
+			}
+			if params.LeasedBefore, err = parseTime(leasedBefore); err != nil {
+				return err
+			}
+			if fl.Changed("min-nonce") {
+				params.MinNonce = &minNonce
+			}
+			if fl.Changed("max-nonce") {
+				params.MaxNonce = &maxNonce
+			}
+			if fl.Changed("limit") {
+				params.Limit = &limit
+			}
+			if fl.Changed("cursor") {
+				params.Cursor = &cursor
+			}
+
+			resp, err := c.GetTickets(cmd.Context(), args[0], params)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				ticketRows(w, resp)
+				nextCursorRow(w, resp.NextCursor)
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVar(&state, "state", "", "only tickets in this state: leased, closed or replaced")
+	fl.StringVar(&leasedAfter, "leased-after", "", "only tickets leased after this RFC 3339 time")
+	fl.StringVar(&leasedBefore, "leased-before", "", "only tickets leased before this RFC 3339 time")
+	fl.Int64Var(&minNonce, "min-nonce", 0, "only tickets with at least this nonce")
+	fl.Int64Var(&maxNonce, "max-nonce", 0, "only tickets with at most this nonce")
+	fl.IntVar(&limit, "limit", 0, "page size")
+	fl.StringVar(&cursor, "cursor", "", "cursor of the page to list")
+
+	return cmd
+}
+
+// newUpdateTicketsCommand releases or closes tickets. A single ticket is updated on its own, honouring an idempotency
+// key, many tickets are updated in bulk.
+func newUpdateTicketsCommand(use string, short string, state openapi.TicketBulkUpdateItemState) *cobra.Command {
+	var idempotencyKey, ifMatch string
+
+	cmd := &cobra.Command{
+		Use:   use + " LINEAGE_ID EXT_ID...",
+		Short: short,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			fl := cmd.Flags()
+			if len(args) == 2 {
+				params := &openapi.UpdateTicketParams{}
+				if fl.Changed("idempotency-key") {
+					params.IdempotencyKey = &idempotencyKey
+				}
+				if fl.Changed("if-match") {
+					params.IfMatch = &ifMatch
+				}
+
+				if state == openapi.TicketBulkUpdateItemStateReleased {
+					err = c.ReleaseTicket(cmd.Context(), args[0], args[1], params)
+				} else {
+					err = c.CloseTicket(cmd.Context(), args[0], args[1], params)
+				}
+				if err != nil {
+					return err
+				}
+
+				return render(cmd, map[string]string{"extId": args[1], "state": string(state)}, func(w *tabwriter.Writer) {
+					row(w, "EXT ID", "STATE")
+					row(w, args[1], state)
+				})
+			}
+
+			if fl.Changed("idempotency-key") {
+				return fmt.Errorf("--idempotency-key can only be used with a single ticket")
+			}
+
+			params := &openapi.UpdateTicketsParams{}
+			if fl.Changed("if-match") {
+				params.IfMatch = &ifMatch
+			}
+
+			req := openapi.TicketBulkUpdateRequest{}
+			for _, extId := range args[1:] {
+				req.Tickets = append(req.Tickets, openapi.TicketBulkUpdateItem{ExtId: extId, State: state})
+			}
+
+			resp, err := c.UpdateTickets(cmd.Context(), args[0], params, req)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				row(w, "EXT ID", "OUTCOME")
+				for _, r := range resp.Results {
+					row(w, r.ExtId, r.Outcome)
+				}
+			})
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVar(&idempotencyKey, "idempotency-key", "", "idempotency key of the update of a single ticket")
+	fl.StringVar(&ifMatch, "if-match", "", "only update if the lineage still has this ETag")
+
+	return cmd
+}
+
+func newTransferTicketCommand() *cobra.Command {
+	var ifMatch string
+
+	cmd := &cobra.Command{
+		Use:   "transfer LINEAGE_ID EXT_ID NEW_EXT_ID",
+		Short: "Move the nonce of a leased ticket to a new ticket",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			params := &openapi.TransferTicketParams{}
+			if cmd.Flags().Changed("if-match") {
+				params.IfMatch = &ifMatch
+			}
+
+			resp, err := c.TransferTicket(cmd.Context(), args[0], args[1], params,
+				openapi.TicketTransferRequest{ExtId: args[2]})
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				ticketRows(w, resp)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&ifMatch, "if-match", "", "only transfer if the lineage still has this ETag")
+
+	return cmd
+}
+
+func newTicketHistoryCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "history LINEAGE_ID EXT_ID",
+		Short: "Show the state changes of a ticket",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.GetTicketHistory(cmd.Context(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				row(w, "TIMESTAMP", "ACTION", "NONCE", "ACTOR", "PRINCIPAL")
+				for _, e := range resp.Entries {
+					row(w, e.Timestamp.Format(time.RFC3339), e.Action, e.Nonce, valueOrDash(e.Actor),
+						valueOrDash(e.Principal))
+				}
+			})
+		},
+	}
+}
+
+func newNonceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce",
+		Short: "Inspect nonces",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get LINEAGE_ID NONCE",
+		Short: "Get the status of a nonce, with the ticket holding it if any",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nonce, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("nonce must be a number: %w", err)
+			}
+
+			c, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.GetNonce(cmd.Context(), args[0], nonce)
+			if err != nil {
+				return err
+			}
+
+			return render(cmd, resp, func(w *tabwriter.Writer) {
+				leasedAt := interface{}("-")
+				if resp.LeasedAt != nil {
+					leasedAt = resp.LeasedAt.Format(time.RFC3339)
+				}
+
+				row(w, "NONCE", "STATUS", "EXT ID", "LEASED AT")
+				row(w, resp.Nonce, resp.Status, valueOrDash(resp.ExtId), leasedAt)
+			})
+		},
+	})
+
+	return cmd
+}
+
+func ticketRows(w *tabwriter.Writer, resp *openapi.TicketLeaseResponse) {
+	row(w, "EXT ID", "NONCE", "STATE", "LEASED AT")
+	if resp.Leases != nil {
+		for _, l := range *resp.Leases {
+			leasedAt := interface{}("-")
+			if l.LeasedAt != nil {
+				leasedAt = l.LeasedAt.Format(time.RFC3339)
+			}
+			row(w, l.ExtId, l.Nonce, l.State, leasedAt)
+		}
+	}
+
+	if resp.Rejected != nil {
+		for _, r := range *resp.Rejected {
+			row(w, r.ExtId, "-", "rejected: "+string(r.Code), "-")
+		}
+	}
+
+	if resp.MissingExtIds != nil {
+		for _, extId := range *resp.MissingExtIds {
+			row(w, extId, "-", "missing", "-")
+		}
+	}
+}
+
+// parseTime parses an optional RFC 3339 time.
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("time %q must be in RFC 3339 format: %w", value, err)
+	}
+
+	return &t, nil
+}
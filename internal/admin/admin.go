@@ -0,0 +1,239 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/rs/zerolog/log"
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+	"github.com/welthee/dinonce/v2/internal/api"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"github.com/welthee/dinonce/v2/internal/webhook"
+	"github.com/ziflex/lecho/v3"
+)
+
+// DefaultPort is the port the admin API listens on unless configured otherwise.
+const DefaultPort = 5012
+
+// baseURL is the path prefix of the admin routes, so they are never mistaken for routes of the ticketing API.
+const baseURL = "/admin"
+
+// Credentials are the hex encoded SHA-256 digests of the API keys granting access to the admin API.
+type Credentials []string
+
+// Handler serves the admin API on a listener of its own, apart from the ticketing API used by executors. Webhooks
+// are managed here since the dispatcher makes requests on their behalf from within the network of the service.
+type Handler struct {
+	e             *echo.Echo
+	administrator ticket.Administrator
+	webhooks      webhook.Store
+	credentials   Credentials
+	port          int
+}
+
+func NewHandler(administrator ticket.Administrator, webhooks webhook.Store, credentials Credentials,
+	port int) *Handler {
+
+	var _ admin.ServerInterface = &Handler{}
+	e := echo.New()
+	e.HideBanner = true
+
+	return &Handler{
+		e:             e,
+		administrator: administrator,
+		webhooks:      webhooks,
+		credentials:   credentials,
+		port:          port,
+	}
+}
+
+func (h *Handler) GetLineageState(ctx echo.Context, namespace string, lineageId string) error {
+	resp, err := h.administrator.GetLineageState(scope(ctx, namespace, ""), lineageId)
+	if err != nil {
+		return respondServicerError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) PauseLineage(ctx echo.Context, namespace string, lineageId string,
+	params admin.PauseLineageParams) error {
+
+	resp, err := h.administrator.PauseLineage(scope(ctx, namespace, params.XActor), lineageId, true)
+	if err != nil {
+		return respondServicerError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ResumeLineage(ctx echo.Context, namespace string, lineageId string,
+	params admin.ResumeLineageParams) error {
+
+	resp, err := h.administrator.PauseLineage(scope(ctx, namespace, params.XActor), lineageId, false)
+	if err != nil {
+		return respondServicerError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ResetLineage(ctx echo.Context, namespace string, lineageId string,
+	params admin.ResetLineageParams) error {
+
+	req := &admin.LineageResetRequest{}
+	if err := ctx.Bind(req); err != nil {
+		return err
+	}
+
+	resp, err := h.administrator.ResetLineage(scope(ctx, namespace, params.XActor), lineageId, req)
+	if err != nil {
+		return respondServicerError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) RepairLineage(ctx echo.Context, namespace string, lineageId string,
+	params admin.RepairLineageParams) error {
+
+	resp, err := h.administrator.RepairLineage(scope(ctx, namespace, params.XActor), lineageId)
+	if err != nil {
+		return respondServicerError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ForceReleaseTicket(ctx echo.Context, namespace string, lineageId string, ticketExtId string,
+	params admin.ForceReleaseTicketParams) error {
+
+	resp, err := h.administrator.ForceReleaseTicket(scope(ctx, namespace, params.XActor), lineageId, ticketExtId)
+	if err != nil {
+		return respondServicerError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ForceCloseTicket(ctx echo.Context, namespace string, lineageId string, ticketExtId string,
+	params admin.ForceCloseTicketParams) error {
+
+	resp, err := h.administrator.ForceCloseTicket(scope(ctx, namespace, params.XActor), lineageId, ticketExtId)
+	if err != nil {
+		return respondServicerError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// scope returns the context of the request scoped to the namespace of its path and attributed to actor.
+func scope(ctx echo.Context, namespace string, actor string) context.Context {
+	rCtx := ticket.WithNamespace(ctx.Request().Context(), namespace)
+	if actor != "" {
+		rCtx = ticket.WithActor(rCtx, actor)
+	}
+
+	return rCtx
+}
+
+func respondServicerError(ctx echo.Context, err error) error {
+	switch err {
+	case ticket.ErrInvalidRequest:
+		return respondError(ctx, http.StatusBadRequest, api.ErrorCodeBadRequest, err.Error())
+	case ticket.ErrNoSuchLineage, ticket.ErrNoSuchTicket:
+		return respondError(ctx, http.StatusNotFound, api.ErrorCodeNotFound, err.Error())
+	default:
+		return err
+	}
+}
+
+func respondError(ctx echo.Context, status int, code string, message string) error {
+	return ctx.JSON(status, admin.Error{
+		Code:    code,
+		Message: message,
+	})
+}
+
+func (h *Handler) Start() error {
+	if len(h.credentials) == 0 {
+		return fmt.Errorf("the admin API requires at least one API key")
+	}
+
+	h.e.Use(echomiddleware.Recover())
+	h.e.Use(echomiddleware.RequestID())
+
+	h.enableLoggingMiddleware()
+	h.e.Use(h.authenticator)
+
+	if err := h.enableOpenApiValidatorMiddleware(); err != nil {
+		return err
+	}
+
+	admin.RegisterHandlersWithBaseURL(h.e, h, baseURL)
+
+	return h.e.Start(fmt.Sprintf(":%d", h.port))
+}
+
+func (h *Handler) Stop(ctx context.Context) error {
+	return h.e.Shutdown(ctx)
+}
+
+// authenticator rejects every request without one of the admin API keys, unlike the ticketing API there are no
+// routes open to anonymous callers. Changes are recorded with the principal of the key next to the operator.
+func (h *Handler) authenticator(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		key := api.BearerToken(ctx.Request().Header.Get(echo.HeaderAuthorization))
+		if !api.IsAuthorized(key, h.credentials) {
+			log.Ctx(ctx.Request().Context()).Warn().
+				Str("path", ctx.Request().URL.Path).
+				Str("remoteIp", ctx.RealIP()).
+				Msg("rejected admin request without a valid API key")
+
+			return respondError(ctx, http.StatusUnauthorized, api.ErrorCodeUnauthorized, api.ErrUnauthorized.Error())
+		}
+
+		req := ctx.Request()
+		ctx.SetRequest(req.WithContext(ticket.WithPrincipal(req.Context(), api.KeyPrincipal(key))))
+
+		return next(ctx)
+	}
+}
+
+func (h *Handler) enableLoggingMiddleware() {
+	logger := lecho.New(
+		log.Logger,
+		lecho.WithTimestamp(),
+		lecho.WithCaller(),
+		lecho.WithField("component", "admin"),
+	)
+
+	h.e.Logger = logger
+
+	h.e.Use(lecho.Middleware(lecho.Config{
+		Logger:       logger,
+		RequestIDKey: "traceId",
+	}))
+}
+
+func (h *Handler) enableOpenApiValidatorMiddleware() error {
+	swagger, err := admin.GetSwagger()
+	if err != nil {
+		return err
+	}
+
+	// the only server is the relative /admin prefix, so requests are matched by path regardless of their host
+	h.e.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
+		Options: openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+		SilenceServersWarning: true,
+	}))
+
+	return nil
+}
@@ -0,0 +1,958 @@
+// Package admin provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.13.2 DO NOT EDIT.
+package admin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	AdminApiKeyScopes = "adminApiKey.Scopes"
+)
+
+// Defines values for ForcedTicketState.
+const (
+	Closed   ForcedTicketState = "closed"
+	Released ForcedTicketState = "released"
+)
+
+// Defines values for LineageEventType.
+const (
+	LineageEventTypeLineageCreated          LineageEventType = "lineage_created"
+	LineageEventTypeLineageLimitApproaching LineageEventType = "lineage_limit_approaching"
+	LineageEventTypeLineageUpdated          LineageEventType = "lineage_updated"
+	LineageEventTypeTicketClosed            LineageEventType = "ticket_closed"
+	LineageEventTypeTicketLeased            LineageEventType = "ticket_leased"
+	LineageEventTypeTicketReleased          LineageEventType = "ticket_released"
+	LineageEventTypeTicketReplaced          LineageEventType = "ticket_replaced"
+	LineageEventTypeTicketTransferred       LineageEventType = "ticket_transferred"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ForcedTicket defines model for ForcedTicket.
+type ForcedTicket struct {
+	ExtId     string            `json:"extId"`
+	LineageId string            `json:"lineageId"`
+	Nonce     int64             `json:"nonce"`
+	State     ForcedTicketState `json:"state"`
+}
+
+// ForcedTicketState defines model for ForcedTicket.State.
+type ForcedTicketState string
+
+// LineageCounters defines model for LineageCounters.
+type LineageCounters struct {
+	ClosedNonceCount int `json:"closedNonceCount"`
+
+	// LeasedNonceCount Nonces handed out and not closed, including released ones.
+	LeasedNonceCount int `json:"leasedNonceCount"`
+
+	// ReleasedNonceCount Released nonces waiting to be leased again.
+	ReleasedNonceCount int `json:"releasedNonceCount"`
+}
+
+// LineageEvent defines model for LineageEvent.
+type LineageEvent struct {
+	// Actor The caller named in the X-Actor header of the change, as asserted by the caller.
+	Actor     *string   `json:"actor,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExtId The extId of the ticket, only set for ticket events.
+	ExtId     *string `json:"extId,omitempty"`
+	LineageId string  `json:"lineageId"`
+
+	// Nonce The nonce of the ticket, only set for ticket events.
+	Nonce *int64 `json:"nonce,omitempty"`
+	Seq   int64  `json:"seq"`
+
+	// Type lineage_limit_approaching marks the moment 90% of the maxLeasedNonceCount of the lineage became in use.
+	Type LineageEventType `json:"type"`
+}
+
+// LineageEventType lineage_limit_approaching marks the moment 90% of the maxLeasedNonceCount of the lineage became in use.
+type LineageEventType string
+
+// LineageRepairResponse defines model for LineageRepairResponse.
+type LineageRepairResponse struct {
+	Before  LineageCounters `json:"before"`
+	Lineage LineageState    `json:"lineage"`
+}
+
+// LineageResetRequest defines model for LineageResetRequest.
+type LineageResetRequest struct {
+	// NextNonce The next nonce of the account of the lineage.
+	NextNonce int64 `json:"nextNonce"`
+}
+
+// LineageState defines model for LineageState.
+type LineageState struct {
+	ClosedNonceCount int    `json:"closedNonceCount"`
+	ExtId            string `json:"extId"`
+	Id               string `json:"id"`
+
+	// LeasedNonceCount Nonces handed out and not closed, including released ones.
+	LeasedNonceCount    int    `json:"leasedNonceCount"`
+	MaxLeasedNonceCount int    `json:"maxLeasedNonceCount"`
+	Namespace           string `json:"namespace"`
+	NextNonce           int64  `json:"nextNonce"`
+	Paused              bool   `json:"paused"`
+
+	// ReleasedNonceCount Released nonces waiting to be leased again.
+	ReleasedNonceCount int   `json:"releasedNonceCount"`
+	Version            int64 `json:"version"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt     time.Time          `json:"createdAt"`
+	EventTypes    []LineageEventType `json:"eventTypes"`
+	ExtIdPrefix   string             `json:"extIdPrefix"`
+	Id            string             `json:"id"`
+	LabelSelector string             `json:"labelSelector"`
+
+	// Secret The signing key of the webhook, only returned on creation.
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookCreationRequest defines model for WebhookCreationRequest.
+type WebhookCreationRequest struct {
+	// EventTypes The types of the events delivered, every type if empty.
+	EventTypes *[]LineageEventType `json:"eventTypes,omitempty"`
+
+	// ExtIdPrefix Only deliver the events of lineages whose extId starts with this prefix.
+	ExtIdPrefix *string `json:"extIdPrefix,omitempty"`
+
+	// LabelSelector Only deliver the events of lineages matching this label selector.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Secret The key deliveries are signed with, a random secret is generated if absent.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url The http or https URL events are POSTed to.
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  int          `json:"attempts"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Event     LineageEvent `json:"event"`
+	Id        int64        `json:"id"`
+	LastError *string      `json:"lastError,omitempty"`
+
+	// LastStatusCode The HTTP status code of the last attempt, absent if no response was received.
+	LastStatusCode *int   `json:"lastStatusCode,omitempty"`
+	WebhookId      string `json:"webhookId"`
+}
+
+// WebhookDeliveryListResponse defines model for WebhookDeliveryListResponse.
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// WebhookListResponse defines model for WebhookListResponse.
+type WebhookListResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// WebhookPayload The body POSTed to the webhooks registered through the admin API. Its signature is sent in the X-Dinonce-Signature header as t={unix timestamp}, v1={hex encoded HMAC-SHA256 of "{timestamp}.{body}" keyed with the secret of the webhook}.
+type WebhookPayload struct {
+	DeliveryId int64        `json:"deliveryId"`
+	Event      LineageEvent `json:"event"`
+	WebhookId  string       `json:"webhookId"`
+}
+
+// WebhookReplayRequest defines model for WebhookReplayRequest.
+type WebhookReplayRequest struct {
+	// DeliveryIds The dead letters to deliver again, every dead letter of the webhook if absent.
+	DeliveryIds *[]int64 `json:"deliveryIds,omitempty"`
+}
+
+// WebhookReplayResponse defines model for WebhookReplayResponse.
+type WebhookReplayResponse struct {
+	// Replayed The number of dead letters queued for delivery again.
+	Replayed int `json:"replayed"`
+}
+
+// Actor defines model for Actor.
+type Actor = string
+
+// LineageId defines model for LineageId.
+type LineageId = string
+
+// Namespace defines model for Namespace.
+type Namespace = string
+
+// TicketExtId defines model for TicketExtId.
+type TicketExtId = string
+
+// WebhookId defines model for WebhookId.
+type WebhookId = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = Error
+
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// WebhookNotFound defines model for WebhookNotFound.
+type WebhookNotFound = Error
+
+// ResumeLineageParams defines parameters for ResumeLineage.
+type ResumeLineageParams struct {
+	// XActor The operator on whose behalf the request is made. It is asserted by the caller and recorded in the ticket history next to the principal of the admin API key the request was made with.
+	XActor Actor `json:"X-Actor"`
+}
+
+// PauseLineageParams defines parameters for PauseLineage.
+type PauseLineageParams struct {
+	// XActor The operator on whose behalf the request is made. It is asserted by the caller and recorded in the ticket history next to the principal of the admin API key the request was made with.
+	XActor Actor `json:"X-Actor"`
+}
+
+// RepairLineageParams defines parameters for RepairLineage.
+type RepairLineageParams struct {
+	// XActor The operator on whose behalf the request is made. It is asserted by the caller and recorded in the ticket history next to the principal of the admin API key the request was made with.
+	XActor Actor `json:"X-Actor"`
+}
+
+// ResetLineageParams defines parameters for ResetLineage.
+type ResetLineageParams struct {
+	// XActor The operator on whose behalf the request is made. It is asserted by the caller and recorded in the ticket history next to the principal of the admin API key the request was made with.
+	XActor Actor `json:"X-Actor"`
+}
+
+// ForceCloseTicketParams defines parameters for ForceCloseTicket.
+type ForceCloseTicketParams struct {
+	// XActor The operator on whose behalf the request is made. It is asserted by the caller and recorded in the ticket history next to the principal of the admin API key the request was made with.
+	XActor Actor `json:"X-Actor"`
+}
+
+// ForceReleaseTicketParams defines parameters for ForceReleaseTicket.
+type ForceReleaseTicketParams struct {
+	// XActor The operator on whose behalf the request is made. It is asserted by the caller and recorded in the ticket history next to the principal of the admin API key the request was made with.
+	XActor Actor `json:"X-Actor"`
+}
+
+// ResetLineageJSONRequestBody defines body for ResetLineage for application/json ContentType.
+type ResetLineageJSONRequestBody = LineageResetRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookCreationRequest
+
+// ReplayWebhookDeadLettersJSONRequestBody defines body for ReplayWebhookDeadLetters for application/json ContentType.
+type ReplayWebhookDeadLettersJSONRequestBody = WebhookReplayRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the state of a lineage
+	// (GET /namespaces/{namespace}/lineages/{lineageId})
+	GetLineageState(ctx echo.Context, namespace Namespace, lineageId LineageId) error
+	// Resume a lineage
+	// (DELETE /namespaces/{namespace}/lineages/{lineageId}/pause)
+	ResumeLineage(ctx echo.Context, namespace Namespace, lineageId LineageId, params ResumeLineageParams) error
+	// Pause a lineage
+	// (PUT /namespaces/{namespace}/lineages/{lineageId}/pause)
+	PauseLineage(ctx echo.Context, namespace Namespace, lineageId LineageId, params PauseLineageParams) error
+	// Repair the counters of a lineage
+	// (POST /namespaces/{namespace}/lineages/{lineageId}/repair)
+	RepairLineage(ctx echo.Context, namespace Namespace, lineageId LineageId, params RepairLineageParams) error
+	// Reset a lineage to the nonce of its account
+	// (POST /namespaces/{namespace}/lineages/{lineageId}/reset)
+	ResetLineage(ctx echo.Context, namespace Namespace, lineageId LineageId, params ResetLineageParams) error
+	// Force close a ticket
+	// (POST /namespaces/{namespace}/lineages/{lineageId}/tickets/{ticketExtId}/force-close)
+	ForceCloseTicket(ctx echo.Context, namespace Namespace, lineageId LineageId, ticketExtId TicketExtId, params ForceCloseTicketParams) error
+	// Force release a ticket
+	// (POST /namespaces/{namespace}/lineages/{lineageId}/tickets/{ticketExtId}/force-release)
+	ForceReleaseTicket(ctx echo.Context, namespace Namespace, lineageId LineageId, ticketExtId TicketExtId, params ForceReleaseTicketParams) error
+	// List the webhooks of a namespace
+	// (GET /namespaces/{namespace}/webhooks)
+	ListWebhooks(ctx echo.Context, namespace Namespace) error
+	// Register a webhook
+	// (POST /namespaces/{namespace}/webhooks)
+	CreateWebhook(ctx echo.Context, namespace Namespace) error
+	// Delete a webhook
+	// (DELETE /namespaces/{namespace}/webhooks/{webhookId})
+	DeleteWebhook(ctx echo.Context, namespace Namespace, webhookId WebhookId) error
+	// Get a webhook
+	// (GET /namespaces/{namespace}/webhooks/{webhookId})
+	GetWebhook(ctx echo.Context, namespace Namespace, webhookId WebhookId) error
+	// List the dead letters of a webhook
+	// (GET /namespaces/{namespace}/webhooks/{webhookId}/dead-letters)
+	ListWebhookDeadLetters(ctx echo.Context, namespace Namespace, webhookId WebhookId) error
+	// Replay the dead letters of a webhook
+	// (POST /namespaces/{namespace}/webhooks/{webhookId}/dead-letters/replay)
+	ReplayWebhookDeadLetters(ctx echo.Context, namespace Namespace, webhookId WebhookId) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetLineageState converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLineageState(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId LineageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLineageState(ctx, namespace, lineageId)
+	return err
+}
+
+// ResumeLineage converts echo context to params.
+func (w *ServerInterfaceWrapper) ResumeLineage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId LineageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResumeLineageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor")]; found {
+		var XActor Actor
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Actor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Actor", runtime.ParamLocationHeader, valueList[0], &XActor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Actor: %s", err))
+		}
+
+		params.XActor = XActor
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Actor is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResumeLineage(ctx, namespace, lineageId, params)
+	return err
+}
+
+// PauseLineage converts echo context to params.
+func (w *ServerInterfaceWrapper) PauseLineage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId LineageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PauseLineageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor")]; found {
+		var XActor Actor
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Actor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Actor", runtime.ParamLocationHeader, valueList[0], &XActor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Actor: %s", err))
+		}
+
+		params.XActor = XActor
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Actor is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PauseLineage(ctx, namespace, lineageId, params)
+	return err
+}
+
+// RepairLineage converts echo context to params.
+func (w *ServerInterfaceWrapper) RepairLineage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId LineageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RepairLineageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor")]; found {
+		var XActor Actor
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Actor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Actor", runtime.ParamLocationHeader, valueList[0], &XActor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Actor: %s", err))
+		}
+
+		params.XActor = XActor
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Actor is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RepairLineage(ctx, namespace, lineageId, params)
+	return err
+}
+
+// ResetLineage converts echo context to params.
+func (w *ServerInterfaceWrapper) ResetLineage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId LineageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResetLineageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor")]; found {
+		var XActor Actor
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Actor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Actor", runtime.ParamLocationHeader, valueList[0], &XActor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Actor: %s", err))
+		}
+
+		params.XActor = XActor
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Actor is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResetLineage(ctx, namespace, lineageId, params)
+	return err
+}
+
+// ForceCloseTicket converts echo context to params.
+func (w *ServerInterfaceWrapper) ForceCloseTicket(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId LineageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	// ------------- Path parameter "ticketExtId" -------------
+	var ticketExtId TicketExtId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ctx.Param("ticketExtId"), &ticketExtId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ticketExtId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ForceCloseTicketParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor")]; found {
+		var XActor Actor
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Actor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Actor", runtime.ParamLocationHeader, valueList[0], &XActor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Actor: %s", err))
+		}
+
+		params.XActor = XActor
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Actor is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForceCloseTicket(ctx, namespace, lineageId, ticketExtId, params)
+	return err
+}
+
+// ForceReleaseTicket converts echo context to params.
+func (w *ServerInterfaceWrapper) ForceReleaseTicket(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId LineageId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	// ------------- Path parameter "ticketExtId" -------------
+	var ticketExtId TicketExtId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ctx.Param("ticketExtId"), &ticketExtId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ticketExtId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ForceReleaseTicketParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor")]; found {
+		var XActor Actor
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Actor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Actor", runtime.ParamLocationHeader, valueList[0], &XActor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Actor: %s", err))
+		}
+
+		params.XActor = XActor
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Actor is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForceReleaseTicket(ctx, namespace, lineageId, ticketExtId, params)
+	return err
+}
+
+// ListWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWebhooks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListWebhooks(ctx, namespace)
+	return err
+}
+
+// CreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateWebhook(ctx, namespace)
+	return err
+}
+
+// DeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, ctx.Param("webhookId"), &webhookId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteWebhook(ctx, namespace, webhookId)
+	return err
+}
+
+// GetWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, ctx.Param("webhookId"), &webhookId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhook(ctx, namespace, webhookId)
+	return err
+}
+
+// ListWebhookDeadLetters converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWebhookDeadLetters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, ctx.Param("webhookId"), &webhookId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListWebhookDeadLetters(ctx, namespace, webhookId)
+	return err
+}
+
+// ReplayWebhookDeadLetters converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplayWebhookDeadLetters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace Namespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, ctx.Param("webhookId"), &webhookId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookId: %s", err))
+	}
+
+	ctx.Set(AdminApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplayWebhookDeadLetters(ctx, namespace, webhookId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/namespaces/:namespace/lineages/:lineageId", wrapper.GetLineageState)
+	router.DELETE(baseURL+"/namespaces/:namespace/lineages/:lineageId/pause", wrapper.ResumeLineage)
+	router.PUT(baseURL+"/namespaces/:namespace/lineages/:lineageId/pause", wrapper.PauseLineage)
+	router.POST(baseURL+"/namespaces/:namespace/lineages/:lineageId/repair", wrapper.RepairLineage)
+	router.POST(baseURL+"/namespaces/:namespace/lineages/:lineageId/reset", wrapper.ResetLineage)
+	router.POST(baseURL+"/namespaces/:namespace/lineages/:lineageId/tickets/:ticketExtId/force-close", wrapper.ForceCloseTicket)
+	router.POST(baseURL+"/namespaces/:namespace/lineages/:lineageId/tickets/:ticketExtId/force-release", wrapper.ForceReleaseTicket)
+	router.GET(baseURL+"/namespaces/:namespace/webhooks", wrapper.ListWebhooks)
+	router.POST(baseURL+"/namespaces/:namespace/webhooks", wrapper.CreateWebhook)
+	router.DELETE(baseURL+"/namespaces/:namespace/webhooks/:webhookId", wrapper.DeleteWebhook)
+	router.GET(baseURL+"/namespaces/:namespace/webhooks/:webhookId", wrapper.GetWebhook)
+	router.GET(baseURL+"/namespaces/:namespace/webhooks/:webhookId/dead-letters", wrapper.ListWebhookDeadLetters)
+	router.POST(baseURL+"/namespaces/:namespace/webhooks/:webhookId/dead-letters/replay", wrapper.ReplayWebhookDeadLetters)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xbbXPbNvL/Khi2/1elJCdNM1PPdObvJmmbO1+Ss93pzaW5DESuRNQkwACgbZ1H3/1m",
+	"FwCfROohsePMXV/ZEsHFYve3z9BtlKiiVBKkNdHxbVRyzQuwoOnTSWKVxn9SMIkWpRVKRsfRRQZMlaC5",
+	"VZopya4zZYDNIeP5gtkMmIYPFRjLhGEFT2HKXtL/3BjQFlI2X9GyhOc5aMZlyjQkSqeQMiHpkRXJJViW",
+	"CWOVXjEJN5ZZRY9KLWQiSp4z5XbjaSEkO3nzkl3CqrP/NXcMsGths+nvMoojgQfIgKegoziSvIDoOPrH",
+	"xJ00jvBNoSGNjq2uII5MkkHBUQQFvzkFubRZdPz4u+/iqBAyfH4URyW3FjTS/tdbPvn3yeSfR5Pv2fT9",
+	"/x/Pvpm8++brKI7sqsS9jNVCLqP1Oo5OhQS+hJcp0ifGSm6zhq28fr6NsU26r3gBpuQJjNCV9fPD6F6Q",
+	"Ul7c2FGObWvFYbR/g3mm1OUo5ev6+SF017jYlEoaIED/yNMzhw38lChpQdK/vCxzkXAE+OwPgyi/bZH9",
+	"WsMiOo6+mjXGMnNPzeyF1kq7rbpWMudpAGKESlH2J1XJ9P43loqZKsmYhw9T2ptT1Mj583PjFUjK9i8h",
+	"Tfceeh6NLsUKp6hEpTCg0DgqwBi+hGEQNcB46yg069/VBqjmf0BCsvhJ6QRSh+pNFiDgfIOHvG23G0+l",
+	"ks7yFkoX3EbHkZD26ZPGAwhpYQka1xrLLa0FWRXItYYcuAFEeZIr/OddvOOgbS8B3vIcD4H+0Nm973mm",
+	"Khm8fU8DtP0rJESLWmdtHcBx213V1T89MyzjEt27qiz5e6ksczvETMgkr1IhlyycnikJZjoosbBk25Zn",
+	"gYx0e19zYZG8VWwOzD/jSy7k0B598fa3G+Qh3pTXFqm/uPL21hU5Hw+3PlSiM6xjpI9azEWzEA2TjMsl",
+	"xIyPxdvpZiyKo0QDt5Ce2A5wU25hYkUBQ6/U9rHJLT0KDDnfEzMl8xUzYNmidkgMUBBmkKM9jWxzc3p0",
+	"2Ob7WCp82NOm3TfbvWUbBxe4fptR49aebFtRu/B1sSoHJOQJv89FIex7XpZa8SRD6yi4vjQktEIVIC37",
+	"/uj/ghgp+elCPjwKUWYOCS8AsVkZcLlWcGphT897VCv3fVWmvW82+MKjk77e157Rf9bQ/8a7zNaKMudJ",
+	"+xuruTQL0HrIs8bRzQR5nlxxTTkSMt8XaXCc9VlGFvxaH21kwSme9KRz0P5KF5pOwymHH59Bvn3Bs1xt",
+	"e3zWCGl4wUVbZg3IzqDkQp/59GrTm81hofS+llDHocby93zznEJc33785g2xLdZyBgalUKeF3WNg6fFq",
+	"i7fByqTjcniSDNjHoJsphBQF2sjRzjDUsLHlJOchneB5/noRHb89VPb750Bi+OsBRzGcOMh2jbJBpiP0",
+	"PXxuySsDbY7mSuXAJT67Am2EkntR6gldpFHcqZbq/Krmb/jIza41b5tqe9ek4wPp10cE5GC2REBYKMzh",
+	"caimy7XmqzrOv9GwEDeHICHnc8jPIYeQ1GysMJBosMNmZcRSYlDCit4bki8gfCzXYCstKVVkJCuh5GAe",
+	"Uel8d7FAisaVHSn2D9GVxa5Y7FX7zDM36mC6atsUBRI2QQi02LAUcnEFGrNnuAK9okVMLBgUpV2hHO5L",
+	"/V3+XqMqPDNt/tQi+D3ju0NEhhnLtTXUjmE2E4aVRHgazMj1U54+iffA0+GcFNy6VIe2JoLMeIqD2NmG",
+	"0Euo9xNgGNcOtJDS6WLGmeYyVQVzRLD/tQQJGhGDmuJzA9L2Tr7RWXo6juhNljJrS6z28a9hv56dBiEg",
+	"c29en19AyqzqRKJKi2hXgYn7bQH4cyeE1UA9Yy3i0QxHgI/1cIeAuvFPewSRnBtbtyMG8GcsxtfKPPO9",
+	"iU0F/HJx8QYxbivDEpXWKQG+y7w0Yq95xIBULLSnqFepIQFxBelw6XvdbpHt4c/aLTMnubhRyZ7eKyj3",
+	"VBg7nuo1drB35OnR3/Q8vRO1ttjC7nY2vUAOZnInczXhLay94atc8ZGCea7SVWOh7XCHoFgKY0Hjo0yr",
+	"apl1u97YXTfke7itNKCjcfgKXYLngvLTyXm9xHcMuGH2h9tKihuGFmcsL8p1zK4e/XCbwQ0DiRhO2S9/",
+	"O3k2Of/l5PF3TxHQv0e3zerpLbK+/j1Cf+h9H23rvV43dq9dXTgIntXLfe30o7zAAcbTYmjIiLbomEqp",
+	"1WikbwiPhPoUeMpysBa0QRz4F1yvKoT61qKeeLthpUb43j2LFrp3nXDMxKjiXsEIzGVVzB3XnZN+qKCC",
+	"lFozQUR7t+fqDTfV4gJ4pYVdnSMqfFBCuzkpxV9hVXe3qWIArmkm5KlgEHX9bCEXaiDh8AOwCaWjbhwm",
+	"lDQxM6CvXGLKmQGcqFksA40FCZottCparSlMR8iILzJYsfmq5MY1YXSVg2FwA0lllXYxPIMc/UPMjPJg",
+	"CJOuojKWJVzrFVMSBidjhlqCzJ2TWXUJMqZeLK5UEoxrHSJDTcaEZLEAcov8kYcbkFPm2gWODPjJWwYa",
+	"iPd6wscNajoZm/U5F2GFzQFRUwvpHKWq2Uk4UqvGOo4eTY+mRwhTVYLkpYiOo2+nR9NvqfyyGSl+Vhdy",
+	"ZnZb/7+ehcPObuum2xrXL13mV2sWXUf0M9hOrR13JqYj9XazZNaM5tbxzsXNfHD9rjfIenx0dGejm14j",
+	"ZR0PWC4NEnotDZT3k6OjMfI1v7PW1I1eebL7lXo+RVZcFQXXKyd+Ztvs8IaZdXyQimdUl3u3DBY2dX0G",
+	"pipCt+6zaXr3YjK6h4dEaPwKg3lsruQSNPPNjs+PDKesNh7iqKwGh0MYHWgKRKUgORjT72ZX0oqcCSrb",
+	"NJFOp8y1eepXEi6ZsSLPcaoUmtGxH2s5z9p0Tp1b6wLsDQrrT3ztxteDoYo09ClORlOTnPIkZQbR6JrF",
+	"hD0PoHoKihDyaPKTzB5KKZcQ1tSQ5DJtXnfvxGwhbhDpiW/zsutMJBlLtVhYSB0Nm0ExhFDX4/9fhmhv",
+	"yjGC1Vq2bu7gLyHhm3WG5T5C+oDR052F2TbDnxZDNRiw29DNc7GUHcxShYiYreckMiGBrViKqf8STQCm",
+	"yynjCwva+VCeIEXDrkGDq265xgOy1NW2U/aC0mH6wOaQq2tW9+jRgyRKGpFSEY2u5LhvJZSipsIk3OWo",
+	"Mg33BIJpOaLCYu6xCg9dmCDmkYAz1pAIG8YttuP4XF1Bix06hMT8nXiJm/cpL+7av81A6DaT7esLzngb",
+	"ykoOG7EB+2XbMMH7R5Wu7t58W7O99Xrdvzy2/kKCnOv+GbAPkzqBbbxAaEDVQ82WsR7uIbz5zG5bVwPX",
+	"MwL6hMxl3HvQ3JrxgHhHAMtqf9MUXZesK2Qf1jApk8p6F4KXEjCCC8sSzU0GqfcqFDQbz+JuigqJxovb",
+	"YrzkIfaG+pRToguLBSR2yM7oOhkx7ez/C7K19s3NLyO8du7ejRiHFzy6b9LEA9gGsem2Z7y+RnmXRuC9",
+	"/bYgSgsaQ1C6i8zYD0MRtIhrZ7jeiltG3b/7xhzlIawL429M8QWNFfvm4qIXNXgEmWSSIcUxo/BH+NMs",
+	"7tIsXMhwGn0wy9A1OPewjfb8ZbC7huOb38KiT4DJfWpoaNI0oqhw3lC2yYbDjiiRVnfmQ1l5a3lc+wa8",
+	"wznniRNi3rtOevu1T6WmOJX5albpfN12LD2BjvwWYmNedNCV+3vK53pTtP1Tua5aTuSKPb656QxpeXIp",
+	"1XUO6RJc6z0MIcLERdkMNF5dTlQBriNktcAx7Xq9HtF9c/3A3Z/0Nw/qznofEt0ZPT3DAX5vwMNNuGTQ",
+	"lQf7y/nrV1P2Exc5pP1rCZ5bV3nBjZOs4DlDHKnFguoMkCmrStygM5rp7R9qNU/T0YebjFfGYu70vNnZ",
+	"Yk9QlbhHjL/ZueIWXF0l5OUkVwnPGU9TDcaAYQsuclbJHIwJJQ/Ya6UvUdw0O6FxQTCQKc9zdQ3pK7fI",
+	"DEUed0PTy+mTvcm9Ibp/P2gvZD+6ay52uDAfbMIgOvYYcDzR3AlVjlmCvzLzMfGoV5S4zRjv/HJkV2iZ",
+	"3dYD23W3t98923P6voNtq5ZAhl53KEqQ9LOEtj3JtGMg0w3YOcp3ALvd+Ujzm6mBiPdkeATbVqgTzr0m",
+	"D/2fG3WV7LXQUnE8OnZ7eIkefWajezi9/Ez9gI+1uxkayMQbSCvX6x60Tnpa1tWLNq6iX7ig5up2H2vc",
+	"JT4MEz4QxUzlKRjLFkIbu2mVrczyOfD01DP3XwSmwZtaIwDbEuIfDnQtQHS5uyMkztxVkfFC++94DWVr",
+	"+rN5QyV20YKzhQaT0S+M1CKAcjo0T8n56qGBeG+5TPf+03rz97f3gPvejaR9EE8N+IFLRw+HfXeInehv",
+	"3WgikHTuMr19h9qly0ceQ3RROJrRKvy5wX8GAH8sWqztPwAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
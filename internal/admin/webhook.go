@@ -0,0 +1,89 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+	"github.com/welthee/dinonce/v2/internal/api"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"github.com/welthee/dinonce/v2/internal/webhook"
+)
+
+const ErrorCodeNoSuchWebhook = "no_such_webhook"
+
+func (h *Handler) CreateWebhook(ctx echo.Context, namespace string) error {
+	req := &admin.WebhookCreationRequest{}
+	if err := ctx.Bind(req); err != nil {
+		return err
+	}
+
+	resp, err := h.webhooks.CreateWebhook(scope(ctx, namespace, ""), req)
+	if err != nil {
+		return webhookError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, resp)
+}
+
+func (h *Handler) ListWebhooks(ctx echo.Context, namespace string) error {
+	resp, err := h.webhooks.ListWebhooks(scope(ctx, namespace, ""))
+	if err != nil {
+		return webhookError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetWebhook(ctx echo.Context, namespace string, webhookId string) error {
+	resp, err := h.webhooks.GetWebhook(scope(ctx, namespace, ""), webhookId)
+	if err != nil {
+		return webhookError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) DeleteWebhook(ctx echo.Context, namespace string, webhookId string) error {
+	if err := h.webhooks.DeleteWebhook(scope(ctx, namespace, ""), webhookId); err != nil {
+		return webhookError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) ListWebhookDeadLetters(ctx echo.Context, namespace string, webhookId string) error {
+	resp, err := h.webhooks.ListDeadLetters(scope(ctx, namespace, ""), webhookId)
+	if err != nil {
+		return webhookError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ReplayWebhookDeadLetters(ctx echo.Context, namespace string, webhookId string) error {
+	req := &admin.WebhookReplayRequest{}
+	if ctx.Request().ContentLength != 0 {
+		if err := ctx.Bind(req); err != nil {
+			return err
+		}
+	}
+
+	resp, err := h.webhooks.ReplayDeadLetters(scope(ctx, namespace, ""), webhookId, req)
+	if err != nil {
+		return webhookError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func webhookError(ctx echo.Context, err error) error {
+	switch err {
+	case webhook.ErrNoSuchWebhook:
+		return respondError(ctx, http.StatusNotFound, ErrorCodeNoSuchWebhook, err.Error())
+	case ticket.ErrInvalidRequest:
+		return respondError(ctx, http.StatusBadRequest, api.ErrorCodeBadRequest, err.Error())
+	default:
+		return err
+	}
+}
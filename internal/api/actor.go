@@ -0,0 +1,31 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+// HeaderActor names the caller on whose behalf a request changes tickets, it is recorded in the ticket history. The
+// actor is asserted by the caller, the history also records the principal of the API key the request was made with.
+const HeaderActor = "X-Actor"
+
+// actorExtractor attributes the ticket changes of a request to the actor sent in its X-Actor header, rejecting
+// actors which do not fit in the ticket history.
+func (h *Handler) actorExtractor(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+
+		if actor := req.Header.Get(HeaderActor); actor != "" {
+			if err := ticket.ValidateActor(actor); err != nil {
+				return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest,
+					"X-Actor must be at most 255 letters, digits, spaces or ._@:/+- characters")
+			}
+
+			ctx.SetRequest(req.WithContext(ticket.WithActor(req.Context(), actor)))
+		}
+
+		return next(ctx)
+	}
+}
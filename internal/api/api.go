@@ -2,15 +2,16 @@ package api
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"github.com/welthee/dinonce/v2/internal/ticket"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo-contrib/prometheus"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
@@ -18,26 +19,39 @@ import (
 	"github.com/ziflex/lecho/v3"
 )
 
-const port = 5010
+// DefaultPort is the port the API listens on unless configured otherwise.
+const DefaultPort = 5010
 
 const ErrorCodeNotFound = "not_found"
 const ErrorCodeBadRequest = "bad_request"
 const ErrorCodeTooManyLeasedTickets = "too_many_leased_tickets"
 const ErrTooManyConcurrentRequests = "too_many_concurrent_requests"
+const ErrorCodeNamespaceLimitExceeded = "namespace_limit_exceeded"
+const ErrorCodeUnauthorized = "unauthorized"
+const ErrorCodeLineageConflict = "lineage_conflict"
+const ErrorCodeIdempotencyKeyInProgress = "idempotency_key_in_progress"
+const ErrorCodeIdempotencyKeyReused = "idempotency_key_reused"
+const ErrorCodeNoContiguousRange = "no_contiguous_range"
+const ErrorCodeLineagePaused = "lineage_paused"
 
 type Handler struct {
-	e        *echo.Echo
-	servicer ticket.Servicer
+	e           *echo.Echo
+	servicer    ticket.Servicer
+	credentials NamespaceCredentials
+	port        int
 }
 
-func NewHandler(servicer ticket.Servicer) *Handler {
+func NewHandler(servicer ticket.Servicer, credentials NamespaceCredentials, port int) *Handler {
+
 	var _ api.ServerInterface = &Handler{}
 	e := echo.New()
 	e.HideBanner = true
 
 	return &Handler{
-		e:        e,
-		servicer: servicer,
+		e:           e,
+		servicer:    servicer,
+		credentials: credentials,
+		port:        port,
 	}
 }
 
@@ -57,10 +71,13 @@ func (h *Handler) CreateLineage(ctx echo.Context) error {
 	if err != nil {
 		switch err {
 		case ticket.ErrInvalidRequest:
-			return ctx.JSON(http.StatusBadRequest, api.Error{
-				Code:    ErrorCodeBadRequest,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNamespaceLimitExceeded:
+			return respondError(ctx, http.StatusForbidden, ErrorCodeNamespaceLimitExceeded, err.Error())
+		case ticket.ErrNoSuchNamespace:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrLineageConflict:
+			return respondError(ctx, http.StatusConflict, ErrorCodeLineageConflict, err.Error())
 		default:
 			return err
 		}
@@ -74,19 +91,145 @@ func (h *Handler) GetLineageByExtId(ctx echo.Context, params api.GetLineageByExt
 	if err != nil {
 		switch err {
 		case ticket.ErrNoSuchLineage:
-			return ctx.JSON(http.StatusNotFound, api.Error{
-				Code:    ErrorCodeNotFound,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		default:
+			return err
+		}
+	}
+
+	setETag(ctx, int64(resp.Version))
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetLineage(ctx echo.Context, lineageId string) error {
+	resp, err := h.servicer.GetLineageById(ctx.Request().Context(), lineageId)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		default:
+			return err
+		}
+	}
+
+	setETag(ctx, int64(resp.Version))
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) UpdateLineage(ctx echo.Context, lineageId string) error {
+	req := &api.LineageUpdateRequest{}
+	if err := ctx.Bind(req); err != nil {
+		return err
+	}
+
+	resp, err := h.servicer.UpdateLineage(ctx.Request().Context(), lineageId, req)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		default:
+			return err
+		}
+	}
+
+	setETag(ctx, int64(resp.Version))
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ListLineages(ctx echo.Context, params api.ListLineagesParams) error {
+	resp, err := h.servicer.ListLineages(ctx.Request().Context(), &params)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetLineageStats(ctx echo.Context, params api.GetLineageStatsParams) error {
+	resp, err := h.servicer.GetLineageStats(ctx.Request().Context(), &params)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) CloneLineage(ctx echo.Context, lineageId string) error {
+	req := &api.LineageCloneRequest{}
+	if err := ctx.Bind(req); err != nil {
+		return err
+	}
+
+	resp, err := h.servicer.CloneLineage(ctx.Request().Context(), lineageId, req)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNamespaceLimitExceeded:
+			return respondError(ctx, http.StatusForbidden, ErrorCodeNamespaceLimitExceeded, err.Error())
+		case ticket.ErrNoSuchLineage, ticket.ErrNoSuchNamespace:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrLineageConflict:
+			return respondError(ctx, http.StatusConflict, ErrorCodeLineageConflict, err.Error())
+		default:
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetLineageByAddress(ctx echo.Context, params api.GetLineageByAddressParams) error {
+	resp, err := h.servicer.GetLineageByAddress(ctx.Request().Context(), params.ChainId, params.Address)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
 		default:
 			return err
 		}
 	}
 
+	setETag(ctx, int64(resp.Version))
+
 	return ctx.JSON(http.StatusOK, resp)
 }
 
-func (h *Handler) LeaseTicket(ctx echo.Context, lineageId string) error {
+func (h *Handler) LeaseTicket(ctx echo.Context, lineageId string, params api.LeaseTicketParams) error {
+	if err := applyIfMatch(ctx, params.IfMatch); err != nil {
+		return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "If-Match must be a lineage ETag")
+	}
+
+	if params.Wait != nil {
+		req := ctx.Request()
+		ctx.SetRequest(req.WithContext(ticket.WithWait(req.Context(), time.Duration(*params.Wait)*time.Second)))
+	}
+
+	return h.idempotent(ctx, lineageId, params.IdempotencyKey, func() error {
+		return h.leaseTicket(ctx, lineageId)
+	})
+}
+
+func (h *Handler) leaseTicket(ctx echo.Context, lineageId string) error {
 	req := &api.TicketLeaseRequest{}
 	if err := ctx.Bind(req); err != nil {
 		return err
@@ -96,20 +239,17 @@ func (h *Handler) LeaseTicket(ctx echo.Context, lineageId string) error {
 	if err != nil {
 		switch err {
 		case ticket.ErrInvalidRequest, ticket.ErrNoSuchLineage:
-			return ctx.JSON(http.StatusBadRequest, api.Error{
-				Code:    ErrorCodeBadRequest,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNoContiguousRange:
+			return respondError(ctx, http.StatusUnprocessableEntity, ErrorCodeNoContiguousRange, err.Error())
+		case ticket.ErrLineagePaused:
+			return respondError(ctx, http.StatusLocked, ErrorCodeLineagePaused, err.Error())
 		case ticket.ErrTooManyLeasedTickets:
-			return ctx.JSON(http.StatusTooManyRequests, api.Error{
-				Code:    ErrorCodeTooManyLeasedTickets,
-				Message: err.Error(),
-			})
+			return h.respondTooManyLeasedTickets(ctx, lineageId, err.Error())
+		case ticket.ErrLineageVersionMismatch:
+			return respondError(ctx, http.StatusPreconditionFailed, ErrorCodePreconditionFailed, err.Error())
 		case ticket.ErrTooManyConcurrentRequests:
-			return ctx.JSON(http.StatusConflict, api.Error{
-				Code:    ErrTooManyConcurrentRequests,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusConflict, ErrTooManyConcurrentRequests, err.Error())
 		default:
 			return err
 		}
@@ -119,25 +259,42 @@ func (h *Handler) LeaseTicket(ctx echo.Context, lineageId string) error {
 }
 
 func (h *Handler) GetTicket(ctx echo.Context, lineageId string, ticketExtId string) error {
-	resp, err := h.servicer.GetTicket(ctx.Request().Context(), lineageId, ticketExtId)
+	rCtx := ctx.Request().Context()
+
+	version, err := h.servicer.GetLineageVersion(rCtx, lineageId)
+	var resp *api.TicketLeaseResponse
+	if err == nil {
+		resp, err = h.servicer.GetTicket(rCtx, lineageId, ticketExtId)
+	}
 	if err != nil {
 		switch err {
-		case ticket.ErrNoSuchTicket:
-			return ctx.NoContent(http.StatusNotFound)
+		case ticket.ErrNoSuchTicket, ticket.ErrNoSuchLineage:
+			return respondEmptyError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
 		case ticket.ErrInvalidRequest:
-			return ctx.JSON(http.StatusBadRequest, api.Error{
-				Code:    ErrorCodeBadRequest,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
 		default:
 			return err
 		}
 	}
 
+	setETag(ctx, version)
+
 	return ctx.JSON(http.StatusOK, resp)
 }
 
-func (h *Handler) UpdateTicket(ctx echo.Context, lineageId string, ticketExtId string) error {
+func (h *Handler) UpdateTicket(ctx echo.Context, lineageId string, ticketExtId string,
+	params api.UpdateTicketParams) error {
+
+	if err := applyIfMatch(ctx, params.IfMatch); err != nil {
+		return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "If-Match must be a lineage ETag")
+	}
+
+	return h.idempotent(ctx, lineageId, params.IdempotencyKey, func() error {
+		return h.updateTicket(ctx, lineageId, ticketExtId)
+	})
+}
+
+func (h *Handler) updateTicket(ctx echo.Context, lineageId string, ticketExtId string) error {
 	req := &api.TicketUpdateRequest{}
 	err := ctx.Bind(req)
 	if err != nil {
@@ -150,22 +307,18 @@ func (h *Handler) UpdateTicket(ctx echo.Context, lineageId string, ticketExtId s
 	case api.TicketUpdateRequestStateClosed:
 		err = h.servicer.CloseTicket(ctx.Request().Context(), lineageId, ticketExtId)
 	default:
-		ctx.Error(errors.New("state must be one of:(released,closed)"))
+		return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "state must be one of:(released,closed)")
 	}
 	if err != nil {
 		switch err {
 		case ticket.ErrInvalidRequest, ticket.ErrNoSuchLineage:
-			return ctx.JSON(http.StatusBadRequest, api.Error{
-				Code:    ErrorCodeBadRequest,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
 		case ticket.ErrNoSuchTicket:
-			return ctx.NoContent(http.StatusNotFound)
+			return respondEmptyError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrLineageVersionMismatch:
+			return respondError(ctx, http.StatusPreconditionFailed, ErrorCodePreconditionFailed, err.Error())
 		case ticket.ErrTooManyConcurrentRequests:
-			return ctx.JSON(http.StatusConflict, api.Error{
-				Code:    ErrTooManyConcurrentRequests,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusConflict, ErrTooManyConcurrentRequests, err.Error())
 		default:
 			return err
 		}
@@ -174,18 +327,166 @@ func (h *Handler) UpdateTicket(ctx echo.Context, lineageId string, ticketExtId s
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+func (h *Handler) UpdateTickets(ctx echo.Context, lineageId string, params api.UpdateTicketsParams) error {
+	if err := applyIfMatch(ctx, params.IfMatch); err != nil {
+		return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "If-Match must be a lineage ETag")
+	}
+
+	req := &api.TicketBulkUpdateRequest{}
+	err := ctx.Bind(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := h.servicer.UpdateTickets(ctx.Request().Context(), lineageId, req)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrLineageVersionMismatch:
+			return respondError(ctx, http.StatusPreconditionFailed, ErrorCodePreconditionFailed, err.Error())
+		case ticket.ErrTooManyConcurrentRequests:
+			return respondError(ctx, http.StatusConflict, ErrTooManyConcurrentRequests, err.Error())
+		default:
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) TransferTicket(ctx echo.Context, lineageId string, ticketExtId string,
+	params api.TransferTicketParams) error {
+
+	if err := applyIfMatch(ctx, params.IfMatch); err != nil {
+		return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "If-Match must be a lineage ETag")
+	}
+
+	req := &api.TicketTransferRequest{}
+	if err := ctx.Bind(req); err != nil {
+		return err
+	}
+
+	resp, err := h.servicer.TransferTicket(ctx.Request().Context(), lineageId, ticketExtId, req)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest, ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrNoSuchTicket:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrLineageVersionMismatch:
+			return respondError(ctx, http.StatusPreconditionFailed, ErrorCodePreconditionFailed, err.Error())
+		case ticket.ErrTooManyConcurrentRequests:
+			return respondError(ctx, http.StatusConflict, ErrTooManyConcurrentRequests, err.Error())
+		default:
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
 func (h *Handler) GetTickets(ctx echo.Context, lineageId string, params api.GetTicketsParams) error {
 	rCtx := ctx.Request().Context()
-	resp, err := h.servicer.GetTickets(rCtx, lineageId, params.TicketExtIds)
+	if params.TicketExtIds == nil {
+		return h.listTickets(ctx, lineageId, params)
+	}
+
+	if params.State != nil || params.LeasedAfter != nil || params.LeasedBefore != nil || params.MinNonce != nil ||
+		params.MaxNonce != nil || params.Limit != nil || params.Cursor != nil {
+		return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "ticketExtIds can not be combined with list filters")
+	}
+
+	allOrNothing := params.AllOrNothing != nil && *params.AllOrNothing
+	version, err := h.servicer.GetLineageVersion(rCtx, lineageId)
+	var resp *api.TicketLeaseResponse
+	if err == nil {
+		resp, err = h.servicer.GetTickets(rCtx, lineageId, *params.TicketExtIds, allOrNothing)
+	}
 	if err != nil {
 		switch err {
 		case ticket.ErrNoSuchTicket:
-			return ctx.NoContent(http.StatusNotFound)
+			return respondEmptyError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	setETag(ctx, version)
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) listTickets(ctx echo.Context, lineageId string, params api.GetTicketsParams) error {
+	rCtx := ctx.Request().Context()
+
+	version, err := h.servicer.GetLineageVersion(rCtx, lineageId)
+	var resp *api.TicketLeaseResponse
+	if err == nil {
+		resp, err = h.servicer.ListTickets(rCtx, lineageId, &params)
+	}
+	if err != nil {
+		switch err {
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	setETag(ctx, version)
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetTicketHistory(ctx echo.Context, lineageId string, ticketExtId string) error {
+	resp, err := h.servicer.GetTicketHistory(ctx.Request().Context(), lineageId, ticketExtId)
+	if err != nil {
+		switch err {
+		case ticket.ErrNoSuchLineage, ticket.ErrNoSuchTicket:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) ListLineageEvents(ctx echo.Context, lineageId string, params api.ListLineageEventsParams) error {
+	resp, err := h.servicer.ListLineageEvents(ctx.Request().Context(), lineageId, &params)
+	if err != nil {
+		switch err {
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) GetNonce(ctx echo.Context, lineageId string, nonce int64) error {
+	resp, err := h.servicer.GetNonce(ctx.Request().Context(), lineageId, nonce)
+	if err != nil {
+		switch err {
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
 		case ticket.ErrInvalidRequest:
-			return ctx.JSON(http.StatusBadRequest, api.Error{
-				Code:    ErrorCodeBadRequest,
-				Message: err.Error(),
-			})
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
 		default:
 			return err
 		}
@@ -195,11 +496,27 @@ func (h *Handler) GetTickets(ctx echo.Context, lineageId string, params api.GetT
 }
 
 func (h *Handler) Start() error {
+	if err := h.setUp(true); err != nil {
+		return err
+	}
+
+	return h.e.Start(fmt.Sprintf(":%d", h.port))
+}
+
+// setUp registers the middlewares and the routes of the API. Metrics are registered globally, once per process.
+func (h *Handler) setUp(metrics bool) error {
+	h.e.HTTPErrorHandler = h.errorHandler
+	h.e.Pre(h.versionRewriter)
+	h.e.Pre(h.namespaceRewriter)
 	h.e.Use(echomiddleware.Recover())
 	h.e.Use(echomiddleware.RequestID())
 
 	h.enableLoggingMiddleware()
-	h.enablePrometheus()
+	if metrics {
+		h.enablePrometheus()
+	}
+	h.e.Use(h.namespaceAuthenticator)
+	h.e.Use(h.actorExtractor)
 
 	if err := h.enableOpenApiValidatorMiddleware(); err != nil {
 		return err
@@ -207,7 +524,7 @@ func (h *Handler) Start() error {
 
 	api.RegisterHandlers(h.e, h)
 
-	return h.e.Start(fmt.Sprintf(":%d", port))
+	return nil
 }
 
 func (h *Handler) Stop(ctx context.Context) error {
@@ -240,8 +557,11 @@ func (h *Handler) enableLoggingMiddleware() {
 		return re.MatchString(strings.ToLower(userAgent))
 	}
 
+	// event streams never end on their own, their bodies must not be buffered for the dump
 	dumpConfig := echomiddleware.BodyDumpConfig{
-		Skipper: skipper,
+		Skipper: func(e echo.Context) bool {
+			return skipper(e) || strings.HasSuffix(e.Request().URL.Path, "/stream")
+		},
 		Handler: func(c echo.Context, reqBody, resBody []byte) {
 			log.Ctx(c.Request().Context()).Info().
 				Str("requestBody", string(reqBody)).
@@ -265,7 +585,14 @@ func (h *Handler) enableOpenApiValidatorMiddleware() error {
 	if err != nil {
 		return err
 	}
+
+	// namespaced requests are rewritten before validation and are authenticated by namespaceAuthenticator
+	swagger.Servers = nil
+
 	h.e.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
+		Options: openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
 		Skipper: func(e echo.Context) bool {
 			return e.Request().RequestURI == "/metrics"
 		},
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+const testLineageId = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+// stubServicer answers the servicer calls of the handler tests with the functions set by the test and keeps
+// idempotency keys in memory, calls of methods it does not implement panic.
+type stubServicer struct {
+	ticket.Servicer
+
+	leaseTicket   func(ctx context.Context) (*api.TicketLeaseResponse, error)
+	getTicket     func(ctx context.Context) (*api.TicketLeaseResponse, error)
+	releaseTicket func(ctx context.Context) error
+	closeTicket   func(ctx context.Context) error
+	lineage       *api.LineageGetResponse
+
+	mu         sync.Mutex
+	leaseCalls int
+	keys       map[string]*stubIdempotencyKey
+}
+
+type stubIdempotencyKey struct {
+	fingerprint string
+	resp        *ticket.IdempotentResponse
+}
+
+func newStubServicer() *stubServicer {
+	return &stubServicer{keys: make(map[string]*stubIdempotencyKey)}
+}
+
+func (s *stubServicer) LeaseTicket(ctx context.Context, _ string, _ *api.TicketLeaseRequest) (
+	*api.TicketLeaseResponse, error) {
+
+	s.mu.Lock()
+	s.leaseCalls++
+	s.mu.Unlock()
+
+	return s.leaseTicket(ctx)
+}
+
+func (s *stubServicer) GetLineageVersion(context.Context, string) (int64, error) {
+	return 1, nil
+}
+
+func (s *stubServicer) GetLineageById(context.Context, string) (*api.LineageGetResponse, error) {
+	if s.lineage == nil {
+		return nil, ticket.ErrNoSuchLineage
+	}
+
+	return s.lineage, nil
+}
+
+func (s *stubServicer) GetTicket(ctx context.Context, _ string, _ string) (*api.TicketLeaseResponse, error) {
+	return s.getTicket(ctx)
+}
+
+func (s *stubServicer) ReleaseTicket(ctx context.Context, _ string, _ string) error {
+	return s.releaseTicket(ctx)
+}
+
+func (s *stubServicer) CloseTicket(ctx context.Context, _ string, _ string) error {
+	return s.closeTicket(ctx)
+}
+
+func (s *stubServicer) ReserveIdempotencyKey(ctx context.Context, _ string, key string, fingerprint string) (
+	*ticket.IdempotentResponse, error) {
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.keys[key]
+	switch {
+	case !ok:
+		s.keys[key] = &stubIdempotencyKey{fingerprint: fingerprint}
+		return nil, nil
+	case stored.fingerprint != fingerprint:
+		return nil, ticket.ErrIdempotencyKeyReused
+	case stored.resp == nil:
+		return nil, ticket.ErrIdempotencyKeyInProgress
+	default:
+		return stored.resp, nil
+	}
+}
+
+func (s *stubServicer) CompleteIdempotencyKey(ctx context.Context, _ string, key string,
+	resp *ticket.IdempotentResponse, _ time.Duration) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key].resp = resp
+
+	return nil
+}
+
+func (s *stubServicer) ReleaseIdempotencyKey(ctx context.Context, _ string, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, key)
+
+	return nil
+}
+
+func newTestHandler(t *testing.T, servicer ticket.Servicer) *Handler {
+	h := NewHandler(servicer, NamespaceCredentials{}, 0)
+	if err := h.setUp(false); err != nil {
+		t.Fatalf("can not set up handler %s", err)
+	}
+
+	return h
+}
+
+// serve sends a request to the handler, body is sent as JSON and headers as pairs of names and values.
+func serve(h *Handler, method string, path string, body string, headers ...string) *httptest.ResponseRecorder {
+	return serveContext(context.Background(), h, method, path, body, headers...)
+}
+
+func serveContext(ctx context.Context, h *Handler, method string, path string, body string,
+	headers ...string) *httptest.ResponseRecorder {
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body)).WithContext(ctx)
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	rec := httptest.NewRecorder()
+	h.e.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func leased(context.Context) (*api.TicketLeaseResponse, error) {
+	leases := []api.TicketLease{{LineageId: testLineageId, ExtId: "tx1", Nonce: 0, State: api.TicketLeaseStateLeased}}
+
+	return &api.TicketLeaseResponse{Leases: &leases}, nil
+}
+
+func failing(err error) func(context.Context) (*api.TicketLeaseResponse, error) {
+	return func(context.Context) (*api.TicketLeaseResponse, error) {
+		return nil, err
+	}
+}
+
+func assertStatus(t *testing.T, rec *httptest.ResponseRecorder, status int) {
+	t.Helper()
+
+	if rec.Code != status {
+		t.Errorf("expected status %d, got %d with body %s", status, rec.Code, rec.Body.String())
+	}
+}
+
+func assertErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+
+	var resp api.Error
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("can not read error %s from %s", err, rec.Body.String())
+	}
+
+	if resp.Code != code {
+		t.Errorf("expected error code %s, got %s", code, resp.Code)
+	}
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+const ErrorCodePreconditionFailed = "precondition_failed"
+
+const HeaderETag = "ETag"
+
+// setETag exposes the lineage version of a read. Ticket reads read the version before the tickets, so a change in
+// between leaves them with a stale ETag instead of one that is newer than the tickets returned.
+func setETag(ctx echo.Context, version int64) {
+	ctx.Response().Header().Set(HeaderETag, fmt.Sprintf(`"%d"`, version))
+}
+
+// applyIfMatch makes the ticket changes of the request conditional on the lineage version sent as If-Match.
+func applyIfMatch(ctx echo.Context, ifMatch *api.IfMatch) error {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "*" {
+		return nil
+	}
+
+	etag := strings.TrimPrefix(strings.TrimSpace(*ifMatch), "W/")
+	if len(etag) < 2 || !strings.HasPrefix(etag, `"`) || !strings.HasSuffix(etag, `"`) {
+		return ticket.ErrInvalidRequest
+	}
+
+	version, err := strconv.ParseInt(etag[1:len(etag)-1], 10, 64)
+	if err != nil {
+		return ticket.ErrInvalidRequest
+	}
+
+	req := ctx.Request()
+	ctx.SetRequest(req.WithContext(ticket.WithExpectedVersion(req.Context(), version)))
+
+	return nil
+}
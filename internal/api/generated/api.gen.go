@@ -1,6 +1,6 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/deepmap/oapi-codegen version v1.11.0 DO NOT EDIT.
+// Code generated by github.com/deepmap/oapi-codegen version v1.13.2 DO NOT EDIT.
 package api
 
 import (
@@ -12,16 +12,75 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	NamespaceApiKeyScopes = "namespaceApiKey.Scopes"
+)
+
+// Defines values for LineageEventType.
+const (
+	LineageEventTypeLineageCreated          LineageEventType = "lineage_created"
+	LineageEventTypeLineageLimitApproaching LineageEventType = "lineage_limit_approaching"
+	LineageEventTypeLineageUpdated          LineageEventType = "lineage_updated"
+	LineageEventTypeTicketClosed            LineageEventType = "ticket_closed"
+	LineageEventTypeTicketLeased            LineageEventType = "ticket_leased"
+	LineageEventTypeTicketReleased          LineageEventType = "ticket_released"
+	LineageEventTypeTicketReplaced          LineageEventType = "ticket_replaced"
+	LineageEventTypeTicketTransferred       LineageEventType = "ticket_transferred"
+)
+
+// Defines values for NonceGetResponseStatus.
+const (
+	NonceGetResponseStatusClosed      NonceGetResponseStatus = "closed"
+	NonceGetResponseStatusLeased      NonceGetResponseStatus = "leased"
+	NonceGetResponseStatusNeverIssued NonceGetResponseStatus = "never_issued"
+	NonceGetResponseStatusReleased    NonceGetResponseStatus = "released"
+	NonceGetResponseStatusUnused      NonceGetResponseStatus = "unused"
+)
+
+// Defines values for TicketBulkUpdateItemState.
+const (
+	TicketBulkUpdateItemStateClosed   TicketBulkUpdateItemState = "closed"
+	TicketBulkUpdateItemStateReleased TicketBulkUpdateItemState = "released"
+)
+
+// Defines values for TicketBulkUpdateResultOutcome.
+const (
+	TicketBulkUpdateResultOutcomeAlreadyClosed TicketBulkUpdateResultOutcome = "already_closed"
+	TicketBulkUpdateResultOutcomeClosed        TicketBulkUpdateResultOutcome = "closed"
+	TicketBulkUpdateResultOutcomeNoSuchTicket  TicketBulkUpdateResultOutcome = "no_such_ticket"
+	TicketBulkUpdateResultOutcomeReleased      TicketBulkUpdateResultOutcome = "released"
+)
+
+// Defines values for TicketHistoryEntryAction.
+const (
+	TicketHistoryEntryActionClosed        TicketHistoryEntryAction = "closed"
+	TicketHistoryEntryActionForceClosed   TicketHistoryEntryAction = "force_closed"
+	TicketHistoryEntryActionForceReleased TicketHistoryEntryAction = "force_released"
+	TicketHistoryEntryActionLeased        TicketHistoryEntryAction = "leased"
+	TicketHistoryEntryActionReLeased      TicketHistoryEntryAction = "re_leased"
+	TicketHistoryEntryActionReleased      TicketHistoryEntryAction = "released"
+	TicketHistoryEntryActionReplaced      TicketHistoryEntryAction = "replaced"
+	TicketHistoryEntryActionTransferred   TicketHistoryEntryAction = "transferred"
+)
+
 // Defines values for TicketLeaseState.
 const (
-	TicketLeaseStateClosed TicketLeaseState = "closed"
-	TicketLeaseStateLeased TicketLeaseState = "leased"
+	TicketLeaseStateClosed   TicketLeaseState = "closed"
+	TicketLeaseStateLeased   TicketLeaseState = "leased"
+	TicketLeaseStateReplaced TicketLeaseState = "replaced"
+)
+
+// Defines values for TicketLeaseRejectionCode.
+const (
+	TicketLeaseRejectionCodeNotLeasable          TicketLeaseRejectionCode = "not_leasable"
+	TicketLeaseRejectionCodeTooManyLeasedTickets TicketLeaseRejectionCode = "too_many_leased_tickets"
 )
 
 // Defines values for TicketUpdateRequestState.
@@ -30,17 +89,44 @@ const (
 	TicketUpdateRequestStateReleased TicketUpdateRequestState = "released"
 )
 
+// Defines values for GetTicketsParamsState.
+const (
+	GetTicketsParamsStateClosed   GetTicketsParamsState = "closed"
+	GetTicketsParamsStateLeased   GetTicketsParamsState = "leased"
+	GetTicketsParamsStateReplaced GetTicketsParamsState = "replaced"
+)
+
 // Error defines model for Error.
 type Error struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
+// Labels Arbitrary key/value metadata attached to a lineage.
+type Labels map[string]string
+
+// LineageCloneRequest defines model for LineageCloneRequest.
+type LineageCloneRequest struct {
+	ExtId          string `json:"extId"`
+	IncludeTickets *bool  `json:"includeTickets,omitempty"`
+
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels *Labels `json:"labels,omitempty"`
+}
+
 // LineageCreationRequest defines model for LineageCreationRequest.
 type LineageCreationRequest struct {
-	ExtId               string `json:"extId"`
-	MaxLeasedNonceCount int    `json:"maxLeasedNonceCount"`
-	StartLeasingFrom    *int   `json:"startLeasingFrom,omitempty"`
+	// Address Hex encoded account address, must be set together with chainId. Checksummed and lowercase spellings of an address are equal.
+	Address *string `json:"address,omitempty"`
+
+	// ChainId Chain the lineage's account lives on, must be set together with address.
+	ChainId *int64 `json:"chainId,omitempty"`
+	ExtId   string `json:"extId"`
+
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels              *Labels `json:"labels,omitempty"`
+	MaxLeasedNonceCount int     `json:"maxLeasedNonceCount"`
+	StartLeasingFrom    *int    `json:"startLeasingFrom,omitempty"`
 }
 
 // LineageCreationResponse defines model for LineageCreationResponse.
@@ -49,20 +135,174 @@ type LineageCreationResponse struct {
 	Id    string `json:"id"`
 }
 
+// LineageEvent defines model for LineageEvent.
+type LineageEvent struct {
+	// Actor The caller named in the X-Actor header of the change, as asserted by the caller.
+	Actor     *string   `json:"actor,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExtId The extId of the ticket, only set for ticket events.
+	ExtId     *string `json:"extId,omitempty"`
+	LineageId string  `json:"lineageId"`
+
+	// Nonce The nonce of the ticket, only set for ticket events.
+	Nonce *int64 `json:"nonce,omitempty"`
+	Seq   int64  `json:"seq"`
+
+	// Type lineage_limit_approaching marks the moment 90% of the maxLeasedNonceCount of the lineage became in use.
+	Type LineageEventType `json:"type"`
+}
+
+// LineageEventListResponse defines model for LineageEventListResponse.
+type LineageEventListResponse struct {
+	Events []LineageEvent `json:"events"`
+}
+
+// LineageEventType lineage_limit_approaching marks the moment 90% of the maxLeasedNonceCount of the lineage became in use.
+type LineageEventType string
+
 // LineageGetResponse defines model for LineageGetResponse.
 type LineageGetResponse struct {
-	ExtId               string `json:"extId"`
-	Id                  string `json:"id"`
+	// Address Lowercase hex encoded account address.
+	Address          *string    `json:"address,omitempty"`
+	ChainId          *int64     `json:"chainId,omitempty"`
+	ClosedNonceCount int        `json:"closedNonceCount"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	ExtId            string     `json:"extId"`
+	Id               string     `json:"id"`
+
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels              Labels `json:"labels"`
 	LeasedNonceCount    int    `json:"leasedNonceCount"`
 	MaxLeasedNonceCount int    `json:"maxLeasedNonceCount"`
 	MaxNonceValue       int    `json:"maxNonceValue"`
+	Namespace           string `json:"namespace"`
 	NextNonce           int    `json:"nextNonce"`
 	ReleasedNonceCount  int    `json:"releasedNonceCount"`
+	StartLeasingFrom    *int   `json:"startLeasingFrom,omitempty"`
+	Version             int    `json:"version"`
+}
+
+// LineageListResponse defines model for LineageListResponse.
+type LineageListResponse struct {
+	Lineages []LineageGetResponse `json:"lineages"`
+
+	// NextCursor Cursor of the next page, absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// LineageStatsResponse defines model for LineageStatsResponse.
+type LineageStatsResponse struct {
+	ClosedNonceCount    int `json:"closedNonceCount"`
+	LeasedNonceCount    int `json:"leasedNonceCount"`
+	LineageCount        int `json:"lineageCount"`
+	MaxLeasedNonceCount int `json:"maxLeasedNonceCount"`
+	ReleasedNonceCount  int `json:"releasedNonceCount"`
+}
+
+// LineageUpdateRequest defines model for LineageUpdateRequest.
+type LineageUpdateRequest struct {
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels Labels `json:"labels"`
+}
+
+// NonceGetResponse defines model for NonceGetResponse.
+type NonceGetResponse struct {
+	// ExtId The extId of the ticket holding the nonce, only set for leased and closed nonces.
+	ExtId     *string                `json:"extId,omitempty"`
+	LeasedAt  *time.Time             `json:"leasedAt,omitempty"`
+	LineageId string                 `json:"lineageId"`
+	Nonce     int64                  `json:"nonce"`
+	Status    NonceGetResponseStatus `json:"status"`
+}
+
+// NonceGetResponseStatus defines model for NonceGetResponse.Status.
+type NonceGetResponseStatus string
+
+// Problem An RFC 7807 problem, sent by the /v2 routes in place of an Error.
+type Problem struct {
+	// Detail Explanation of this occurrence of the problem.
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance Path of the request.
+	Instance *string `json:"instance,omitempty"`
+
+	// LeasedNonceCount The leasedNonceCount of the lineage, sent with too_many_leased_tickets.
+	LeasedNonceCount *int `json:"leasedNonceCount,omitempty"`
+
+	// LineageId The lineage the request addressed.
+	LineageId *string `json:"lineageId,omitempty"`
+
+	// MaxLeasedNonceCount The maxLeasedNonceCount of the lineage, sent with too_many_leased_tickets.
+	MaxLeasedNonceCount *int `json:"maxLeasedNonceCount,omitempty"`
+
+	// ReleasedNonceCount The releasedNonceCount of the lineage, sent with too_many_leased_tickets.
+	ReleasedNonceCount *int `json:"releasedNonceCount,omitempty"`
+	Status             int  `json:"status"`
+
+	// Title Summary of the kind of problem.
+	Title string `json:"title"`
+
+	// Type Identifies the kind of problem as urn:dinonce:problem:{code}, the code being the one of an Error.
+	Type string `json:"type"`
+}
+
+// TicketBulkUpdateItem defines model for TicketBulkUpdateItem.
+type TicketBulkUpdateItem struct {
+	ExtId string                    `json:"extId"`
+	State TicketBulkUpdateItemState `json:"state"`
+}
+
+// TicketBulkUpdateItemState defines model for TicketBulkUpdateItem.State.
+type TicketBulkUpdateItemState string
+
+// TicketBulkUpdateRequest defines model for TicketBulkUpdateRequest.
+type TicketBulkUpdateRequest struct {
+	Tickets []TicketBulkUpdateItem `json:"tickets"`
+}
+
+// TicketBulkUpdateResponse defines model for TicketBulkUpdateResponse.
+type TicketBulkUpdateResponse struct {
+	Results []TicketBulkUpdateResult `json:"results"`
+}
+
+// TicketBulkUpdateResult defines model for TicketBulkUpdateResult.
+type TicketBulkUpdateResult struct {
+	ExtId   string                        `json:"extId"`
+	Outcome TicketBulkUpdateResultOutcome `json:"outcome"`
+}
+
+// TicketBulkUpdateResultOutcome defines model for TicketBulkUpdateResult.Outcome.
+type TicketBulkUpdateResultOutcome string
+
+// TicketHistoryEntry defines model for TicketHistoryEntry.
+type TicketHistoryEntry struct {
+	// Action re_leased marks a lease of a nonce which was held by another ticket before, transferred marks a ticket which took over the nonce of a replaced ticket, the force_ prefixed actions are changes done by an admin.
+	Action TicketHistoryEntryAction `json:"action"`
+
+	// Actor The caller named in the X-Actor header of the change, as asserted by the caller.
+	Actor *string `json:"actor,omitempty"`
+	Nonce int64   `json:"nonce"`
+
+	// Principal The API key the change was made with, as key: followed by the first 12 hex digits of the SHA-256 digest of the key. Absent for changes made without an API key.
+	Principal *string   `json:"principal,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TicketHistoryEntryAction re_leased marks a lease of a nonce which was held by another ticket before, transferred marks a ticket which took over the nonce of a replaced ticket, the force_ prefixed actions are changes done by an admin.
+type TicketHistoryEntryAction string
+
+// TicketHistoryResponse defines model for TicketHistoryResponse.
+type TicketHistoryResponse struct {
+	Entries   []TicketHistoryEntry `json:"entries"`
+	ExtId     string               `json:"extId"`
+	LineageId string               `json:"lineageId"`
 }
 
 // TicketLease defines model for TicketLease.
 type TicketLease struct {
 	ExtId     string           `json:"extId"`
+	LeasedAt  *time.Time       `json:"leasedAt,omitempty"`
 	LineageId string           `json:"lineageId"`
 	Nonce     int              `json:"nonce"`
 	State     TicketLeaseState `json:"state"`
@@ -71,14 +311,43 @@ type TicketLease struct {
 // TicketLeaseState defines model for TicketLease.State.
 type TicketLeaseState string
 
+// TicketLeaseRejection defines model for TicketLeaseRejection.
+type TicketLeaseRejection struct {
+	Code  TicketLeaseRejectionCode `json:"code"`
+	ExtId string                   `json:"extId"`
+}
+
+// TicketLeaseRejectionCode defines model for TicketLeaseRejection.Code.
+type TicketLeaseRejectionCode string
+
 // TicketLeaseRequest defines model for TicketLeaseRequest.
 type TicketLeaseRequest struct {
-	ExtIds []string `json:"extIds"`
+	// Contiguous Lease one contiguous range of fresh nonces in the order of extIds. Fails with 422 if the lineage has released nonces waiting to be leased again, or if only some of the extIds are already leased.
+	Contiguous *bool    `json:"contiguous,omitempty"`
+	ExtIds     []string `json:"extIds"`
+
+	// Partial Lease as many of the tickets as the maxLeasedNonceCount of the lineage allows, in the order of extIds. The extIds which could not be leased are returned as rejected instead of failing the request. Can not be combined with contiguous.
+	Partial *bool `json:"partial,omitempty"`
 }
 
 // TicketLeaseResponse defines model for TicketLeaseResponse.
 type TicketLeaseResponse struct {
 	Leases *[]TicketLease `json:"leases,omitempty"`
+
+	// MissingExtIds The requested ticketExtIds without an active or closed lease.
+	MissingExtIds *[]string `json:"missingExtIds,omitempty"`
+
+	// NextCursor Cursor of the next page when listing tickets, absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Rejected The extIds which were not leased by a partial lease request.
+	Rejected *[]TicketLeaseRejection `json:"rejected,omitempty"`
+}
+
+// TicketTransferRequest defines model for TicketTransferRequest.
+type TicketTransferRequest struct {
+	// ExtId The extId of the ticket taking over the nonce.
+	ExtId string `json:"extId"`
 }
 
 // TicketUpdateRequest defines model for TicketUpdateRequest.
@@ -89,33 +358,140 @@ type TicketUpdateRequest struct {
 // TicketUpdateRequestState defines model for TicketUpdateRequest.State.
 type TicketUpdateRequestState string
 
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// LabelSelector defines model for LabelSelector.
+type LabelSelector = string
+
+// LastEventId defines model for LastEventId.
+type LastEventId = string
+
 // GetLineageByExtIdParams defines parameters for GetLineageByExtId.
 type GetLineageByExtIdParams struct {
 	ExtId string `form:"extId" json:"extId"`
 }
 
-// CreateLineageJSONBody defines parameters for CreateLineage.
-type CreateLineageJSONBody = LineageCreationRequest
+// GetLineageByAddressParams defines parameters for GetLineageByAddress.
+type GetLineageByAddressParams struct {
+	ChainId int64  `form:"chainId" json:"chainId"`
+	Address string `form:"address" json:"address"`
+}
+
+// ListLineagesParams defines parameters for ListLineages.
+type ListLineagesParams struct {
+	// LabelSelector Comma separated list of label requirements, all of which must match. Supported requirements are `key=value`, `key!=value`, `key` (label exists) and `!key` (label does not exist).
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	Limit         *int           `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor        *string        `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetLineageStatsParams defines parameters for GetLineageStats.
+type GetLineageStatsParams struct {
+	// LabelSelector Comma separated list of label requirements, all of which must match. Supported requirements are `key=value`, `key!=value`, `key` (label exists) and `!key` (label does not exist).
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// StreamLineagesEventsParams defines parameters for StreamLineagesEvents.
+type StreamLineagesEventsParams struct {
+	// LabelSelector Comma separated list of label requirements, all of which must match. Supported requirements are `key=value`, `key!=value`, `key` (label exists) and `!key` (label does not exist).
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	ExtIdPrefix   *string        `form:"extIdPrefix,omitempty" json:"extIdPrefix,omitempty"`
+
+	// LastEventID The id of the last server-sent event the client received, the stream resumes after it.
+	LastEventID *LastEventId `json:"Last-Event-ID,omitempty"`
+}
+
+// ListLineageEventsParams defines parameters for ListLineageEvents.
+type ListLineageEventsParams struct {
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// StreamLineageEventsParams defines parameters for StreamLineageEvents.
+type StreamLineageEventsParams struct {
+	// LastEventID The id of the last server-sent event the client received, the stream resumes after it.
+	LastEventID *LastEventId `json:"Last-Event-ID,omitempty"`
+}
 
 // GetTicketsParams defines parameters for GetTickets.
 type GetTicketsParams struct {
-	TicketExtIds []string `form:"ticketExtIds" json:"ticketExtIds"`
+	TicketExtIds *[]string `form:"ticketExtIds,omitempty" json:"ticketExtIds,omitempty"`
+
+	// AllOrNothing Fail with 404 unless every ticket with the given ticketExtIds is found.
+	AllOrNothing *bool                  `form:"allOrNothing,omitempty" json:"allOrNothing,omitempty"`
+	State        *GetTicketsParamsState `form:"state,omitempty" json:"state,omitempty"`
+
+	// LeasedAfter Only list tickets leased at or after the given time.
+	LeasedAfter *time.Time `form:"leasedAfter,omitempty" json:"leasedAfter,omitempty"`
+
+	// LeasedBefore Only list tickets leased before the given time.
+	LeasedBefore *time.Time `form:"leasedBefore,omitempty" json:"leasedBefore,omitempty"`
+	MinNonce     *int64     `form:"minNonce,omitempty" json:"minNonce,omitempty"`
+	MaxNonce     *int64     `form:"maxNonce,omitempty" json:"maxNonce,omitempty"`
+	Limit        *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor       *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// LeaseTicketJSONBody defines parameters for LeaseTicket.
-type LeaseTicketJSONBody = TicketLeaseRequest
+// GetTicketsParamsState defines parameters for GetTickets.
+type GetTicketsParamsState string
 
-// UpdateTicketJSONBody defines parameters for UpdateTicket.
-type UpdateTicketJSONBody = TicketUpdateRequest
+// UpdateTicketsParams defines parameters for UpdateTickets.
+type UpdateTicketsParams struct {
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LeaseTicketParams defines parameters for LeaseTicket.
+type LeaseTicketParams struct {
+	// Wait Seconds to wait for tickets of the lineage to be released or closed when the lease would exceed its maxLeasedNonceCount, instead of failing immediately with 429. Waiting requests are served in order of arrival.
+	Wait *int `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// IdempotencyKey Client chosen key identifying the request. The first response sent for a key of a lineage is stored for a day and replayed to retries of the request with the same key, marked by an Idempotent-Replayed header. Responses of requests which failed without changing tickets, 400, 409, 412, 423 and 429, are not stored and their retries are handled again. Server errors are stored, since the request may have changed tickets before it failed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateTicketParams defines parameters for UpdateTicket.
+type UpdateTicketParams struct {
+	// IdempotencyKey Client chosen key identifying the request. The first response sent for a key of a lineage is stored for a day and replayed to retries of the request with the same key, marked by an Idempotent-Replayed header. Responses of requests which failed without changing tickets, 400, 409, 412, 423 and 429, are not stored and their retries are handled again. Server errors are stored, since the request may have changed tickets before it failed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// TransferTicketParams defines parameters for TransferTicket.
+type TransferTicketParams struct {
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
 
 // CreateLineageJSONRequestBody defines body for CreateLineage for application/json ContentType.
-type CreateLineageJSONRequestBody = CreateLineageJSONBody
+type CreateLineageJSONRequestBody = LineageCreationRequest
+
+// UpdateLineageJSONRequestBody defines body for UpdateLineage for application/json ContentType.
+type UpdateLineageJSONRequestBody = LineageUpdateRequest
+
+// CloneLineageJSONRequestBody defines body for CloneLineage for application/json ContentType.
+type CloneLineageJSONRequestBody = LineageCloneRequest
+
+// UpdateTicketsJSONRequestBody defines body for UpdateTickets for application/json ContentType.
+type UpdateTicketsJSONRequestBody = TicketBulkUpdateRequest
 
 // LeaseTicketJSONRequestBody defines body for LeaseTicket for application/json ContentType.
-type LeaseTicketJSONRequestBody = LeaseTicketJSONBody
+type LeaseTicketJSONRequestBody = TicketLeaseRequest
 
 // UpdateTicketJSONRequestBody defines body for UpdateTicket for application/json ContentType.
-type UpdateTicketJSONRequestBody = UpdateTicketJSONBody
+type UpdateTicketJSONRequestBody = TicketUpdateRequest
+
+// TransferTicketJSONRequestBody defines body for TransferTicket for application/json ContentType.
+type TransferTicketJSONRequestBody = TicketTransferRequest
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
@@ -125,18 +501,57 @@ type ServerInterface interface {
 
 	// (POST /lineages)
 	CreateLineage(ctx echo.Context) error
+	// Get lineage by chain and address
+	// (GET /lineages/by-address)
+	GetLineageByAddress(ctx echo.Context, params GetLineageByAddressParams) error
+	// List lineages
+	// (GET /lineages/list)
+	ListLineages(ctx echo.Context, params ListLineagesParams) error
+	// Lineage statistics
+	// (GET /lineages/stats)
+	GetLineageStats(ctx echo.Context, params GetLineageStatsParams) error
+
+	// (GET /lineages/stream)
+	StreamLineagesEvents(ctx echo.Context, params StreamLineagesEventsParams) error
+
+	// (GET /lineages/{lineageId})
+	GetLineage(ctx echo.Context, lineageId string) error
+	// Update lineage
+	// (PATCH /lineages/{lineageId})
+	UpdateLineage(ctx echo.Context, lineageId string) error
+	// Clone lineage
+	// (POST /lineages/{lineageId}/clone)
+	CloneLineage(ctx echo.Context, lineageId string) error
+
+	// (GET /lineages/{lineageId}/events)
+	ListLineageEvents(ctx echo.Context, lineageId string, params ListLineageEventsParams) error
+
+	// (GET /lineages/{lineageId}/nonces/{nonce})
+	GetNonce(ctx echo.Context, lineageId string, nonce int64) error
+
+	// (GET /lineages/{lineageId}/stream)
+	StreamLineageEvents(ctx echo.Context, lineageId string, params StreamLineageEventsParams) error
 
 	// (GET /lineages/{lineageId}/tickets)
 	GetTickets(ctx echo.Context, lineageId string, params GetTicketsParams) error
+
+	// (PATCH /lineages/{lineageId}/tickets)
+	UpdateTickets(ctx echo.Context, lineageId string, params UpdateTicketsParams) error
 	// Lease tickets
 	// (POST /lineages/{lineageId}/tickets)
-	LeaseTicket(ctx echo.Context, lineageId string) error
+	LeaseTicket(ctx echo.Context, lineageId string, params LeaseTicketParams) error
 
 	// (GET /lineages/{lineageId}/tickets/{ticketExtId})
 	GetTicket(ctx echo.Context, lineageId string, ticketExtId string) error
 
 	// (PATCH /lineages/{lineageId}/tickets/{ticketExtId})
-	UpdateTicket(ctx echo.Context, lineageId string, ticketExtId string) error
+	UpdateTicket(ctx echo.Context, lineageId string, ticketExtId string, params UpdateTicketParams) error
+
+	// (GET /lineages/{lineageId}/tickets/{ticketExtId}/history)
+	GetTicketHistory(ctx echo.Context, lineageId string, ticketExtId string) error
+
+	// (POST /lineages/{lineageId}/tickets/{ticketExtId}/transfer)
+	TransferTicket(ctx echo.Context, lineageId string, ticketExtId string, params TransferTicketParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -148,6 +563,8 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) GetLineageByExtId(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetLineageByExtIdParams
 	// ------------- Required query parameter "extId" -------------
@@ -157,7 +574,7 @@ func (w *ServerInterfaceWrapper) GetLineageByExtId(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter extId: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
+	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetLineageByExtId(ctx, params)
 	return err
 }
@@ -166,11 +583,290 @@ func (w *ServerInterfaceWrapper) GetLineageByExtId(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) CreateLineage(ctx echo.Context) error {
 	var err error
 
-	// Invoke the callback with all the unmarshalled arguments
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.CreateLineage(ctx)
 	return err
 }
 
+// GetLineageByAddress converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLineageByAddress(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLineageByAddressParams
+	// ------------- Required query parameter "chainId" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "chainId", ctx.QueryParams(), &params.ChainId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter chainId: %s", err))
+	}
+
+	// ------------- Required query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLineageByAddress(ctx, params)
+	return err
+}
+
+// ListLineages converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLineages(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLineagesParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListLineages(ctx, params)
+	return err
+}
+
+// GetLineageStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLineageStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLineageStatsParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLineageStats(ctx, params)
+	return err
+}
+
+// StreamLineagesEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamLineagesEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamLineagesEventsParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// ------------- Optional query parameter "extIdPrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "extIdPrefix", ctx.QueryParams(), &params.ExtIdPrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter extIdPrefix: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventId
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamLineagesEvents(ctx, params)
+	return err
+}
+
+// GetLineage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLineage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLineage(ctx, lineageId)
+	return err
+}
+
+// UpdateLineage converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateLineage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateLineage(ctx, lineageId)
+	return err
+}
+
+// CloneLineage converts echo context to params.
+func (w *ServerInterfaceWrapper) CloneLineage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CloneLineage(ctx, lineageId)
+	return err
+}
+
+// ListLineageEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLineageEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLineageEventsParams
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListLineageEvents(ctx, lineageId, params)
+	return err
+}
+
+// GetNonce converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNonce(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	// ------------- Path parameter "nonce" -------------
+	var nonce int64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nonce", runtime.ParamLocationPath, ctx.Param("nonce"), &nonce)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nonce: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNonce(ctx, lineageId, nonce)
+	return err
+}
+
+// StreamLineageEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamLineageEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamLineageEventsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventId
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamLineageEvents(ctx, lineageId, params)
+	return err
+}
+
 // GetTickets converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTickets(ctx echo.Context) error {
 	var err error
@@ -182,20 +878,116 @@ func (w *ServerInterfaceWrapper) GetTickets(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
 	}
 
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTicketsParams
-	// ------------- Required query parameter "ticketExtIds" -------------
+	// ------------- Optional query parameter "ticketExtIds" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "ticketExtIds", ctx.QueryParams(), &params.TicketExtIds)
+	err = runtime.BindQueryParameter("form", true, false, "ticketExtIds", ctx.QueryParams(), &params.TicketExtIds)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ticketExtIds: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
+	// ------------- Optional query parameter "allOrNothing" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allOrNothing", ctx.QueryParams(), &params.AllOrNothing)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter allOrNothing: %s", err))
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "leasedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "leasedAfter", ctx.QueryParams(), &params.LeasedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter leasedAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "leasedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "leasedBefore", ctx.QueryParams(), &params.LeasedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter leasedBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "minNonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minNonce", ctx.QueryParams(), &params.MinNonce)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minNonce: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxNonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxNonce", ctx.QueryParams(), &params.MaxNonce)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxNonce: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetTickets(ctx, lineageId, params)
 	return err
 }
 
+// UpdateTickets converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTickets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTicketsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTickets(ctx, lineageId, params)
+	return err
+}
+
 // LeaseTicket converts echo context to params.
 func (w *ServerInterfaceWrapper) LeaseTicket(ctx echo.Context) error {
 	var err error
@@ -207,8 +999,51 @@ func (w *ServerInterfaceWrapper) LeaseTicket(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.LeaseTicket(ctx, lineageId)
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LeaseTicketParams
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", ctx.QueryParams(), &params.Wait)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LeaseTicket(ctx, lineageId, params)
 	return err
 }
 
@@ -231,7 +1066,9 @@ func (w *ServerInterfaceWrapper) GetTicket(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ticketExtId: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetTicket(ctx, lineageId, ticketExtId)
 	return err
 }
@@ -255,8 +1092,117 @@ func (w *ServerInterfaceWrapper) UpdateTicket(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ticketExtId: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.UpdateTicket(ctx, lineageId, ticketExtId)
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTicketParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTicket(ctx, lineageId, ticketExtId, params)
+	return err
+}
+
+// GetTicketHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTicketHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	// ------------- Path parameter "ticketExtId" -------------
+	var ticketExtId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ctx.Param("ticketExtId"), &ticketExtId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ticketExtId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTicketHistory(ctx, lineageId, ticketExtId)
+	return err
+}
+
+// TransferTicket converts echo context to params.
+func (w *ServerInterfaceWrapper) TransferTicket(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "lineageId" -------------
+	var lineageId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, ctx.Param("lineageId"), &lineageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lineageId: %s", err))
+	}
+
+	// ------------- Path parameter "ticketExtId" -------------
+	var ticketExtId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ctx.Param("ticketExtId"), &ticketExtId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ticketExtId: %s", err))
+	}
+
+	ctx.Set(NamespaceApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TransferTicketParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TransferTicket(ctx, lineageId, ticketExtId, params)
 	return err
 }
 
@@ -290,33 +1236,129 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/lineages", wrapper.GetLineageByExtId)
 	router.POST(baseURL+"/lineages", wrapper.CreateLineage)
+	router.GET(baseURL+"/lineages/by-address", wrapper.GetLineageByAddress)
+	router.GET(baseURL+"/lineages/list", wrapper.ListLineages)
+	router.GET(baseURL+"/lineages/stats", wrapper.GetLineageStats)
+	router.GET(baseURL+"/lineages/stream", wrapper.StreamLineagesEvents)
+	router.GET(baseURL+"/lineages/:lineageId", wrapper.GetLineage)
+	router.PATCH(baseURL+"/lineages/:lineageId", wrapper.UpdateLineage)
+	router.POST(baseURL+"/lineages/:lineageId/clone", wrapper.CloneLineage)
+	router.GET(baseURL+"/lineages/:lineageId/events", wrapper.ListLineageEvents)
+	router.GET(baseURL+"/lineages/:lineageId/nonces/:nonce", wrapper.GetNonce)
+	router.GET(baseURL+"/lineages/:lineageId/stream", wrapper.StreamLineageEvents)
 	router.GET(baseURL+"/lineages/:lineageId/tickets", wrapper.GetTickets)
+	router.PATCH(baseURL+"/lineages/:lineageId/tickets", wrapper.UpdateTickets)
 	router.POST(baseURL+"/lineages/:lineageId/tickets", wrapper.LeaseTicket)
 	router.GET(baseURL+"/lineages/:lineageId/tickets/:ticketExtId", wrapper.GetTicket)
 	router.PATCH(baseURL+"/lineages/:lineageId/tickets/:ticketExtId", wrapper.UpdateTicket)
+	router.GET(baseURL+"/lineages/:lineageId/tickets/:ticketExtId/history", wrapper.GetTicketHistory)
+	router.POST(baseURL+"/lineages/:lineageId/tickets/:ticketExtId/transfer", wrapper.TransferTicket)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RXXW/bNhT9KwS3p0GxVDuLV721RVYMKPawdXuY4QGMdC2xk0iFvHLiGfrvA0nJkm3K",
-	"doa42J6iiFf345zDQ3pLE1lWUoBATeMt1UkOJbOP90pJZR4qJStQyMG+TmQK5i9uKqAx1ai4yGgT0BK0",
-	"ZplvrQmogseaK0hpvHAZ+vhl0MXLhy+QoMn1iQtgGXxQwJBL8Qs81qDxuBl4xp9S+5ohghI0pn8u2M3f",
-	"727+iG7ehjfL72jg6ZQ9fwKmIf1ZigQ+yFrY1CmsWF0gje9ubQwv65LGs+n8bh7Qkgv3/5tdQi4QMlAm",
-	"o0am0OTkIvtRyXIvXTTI9nY6nc3m02h298P3t/P5XRRFg9zRce4D7NzA/hEuAlJXUmg4geQRWjw9Tyk3",
-	"PbkUy77qR8BXKxjQwkPZMRMj3HoDbcjvrKjBHyLgGW2Mf1nBJS2NIzUs4BnPW8A/4OE0AV2D0lwKy8Zn",
-	"nvwFVp0voqFwJI6sinFcNDK0SyCMqBftaDSgSSHNwzI4I6e+9ACrFieX3af1wZynDcM+cYRSe0drXzCl",
-	"2Ma/AfX5+mOyt1jsN/CtghWN6Tdhb8Vh68PhkDtfayNN/FalDMdROGKoU9oLOBrjwcRxsZLOAnWieGWc",
-	"h8Zta1xk5FdQa1DGmjkW4F/qFBzTN5NoEpnZZAWCVZzGdDaJJjMaGNvP7URhKxn7TwZ2ZjOxdT0jYfoR",
-	"sLWl95v7VlMVU6wEBKVpvNhSbqo91qA2Rm+sNJ118utHR1VD0B6UPmdcmmBHv+1mGkXu3BQIziJYVRU8",
-	"sa2FX7SZcTvId0oOHmO1gO8D3UYRBag4rCF1QZXUHlzs0QDtJ+2goPG9TDev3fbhcd7sa8oA21wfvKOz",
-	"8ASCiQXH4tcEvcjC7c6hmhCtdk8K73Mb4lecEXEvuKH3XS66wK9e19u986xT+S52w2uq22egHnLekYJr",
-	"JHJFWugJ10brtRKQEpQEcyBJwUHgxExwG92OmZEmTxxz+0HG1yCIs3eSStBESCQ5WwNhgrAE+RqIVMT5",
-	"I7F+OdnfWAcaMhFECvtVycSma5cGBwqxka6hK0pkeZ297Tl1v/K+vlA2LswRR1jSdmf18Xq9uB9NnuoP",
-	"LCVqh09AdV2WTG12Oum0cdZnwu1gUzfnXecrmM5+rkF7/5lz81KJ5B0RJGd6fN+Tp5wn+b+ynb6Cz3le",
-	"ajwMk/yYe3f7+z/Sfy172r8PX+RPo2cGMTffWpPa5kwJE6lRAnDMQZHuJm1f298s5IkXBXkw1zGmNc+M",
-	"WnZ0WiKbpvknAAD//8Xes0GIEQAA",
+	"H4sIAAAAAAAC/+w97XLcOHKvgmOSSi5HjcaybJ9Vlapofb5bJV6vy/LmUtl1bAzZM4MVCdAAKGni0run",
+	"0A3wa8CZkdfSrrT6Y49IEGg0Gv3dwOckU2WlJEhrkqPPyRJ4Dhp/vnzHF+7/HEymRWWFkslR8m4J7FOt",
+	"LOTsHLQRSjI1Z3YJrBAS+AJSdrEU2ZJlXLIZMAPSMm7YyXzvO26zJbOKFcANMC5zVlc5t8CsyM7Amglz",
+	"vYdusyWXCzDsQtglg3PQK/9obUBhTejCDXUBReH+d48LPoPCTH6SSZqYbAkld1OyqwqSo8RYLeQiubq6",
+	"SpOKa16C9XM/yaGslAWZrf4TVutYeFEIN69sqQxIdgYrJnKQVsxXQi4QOA2fajCWpjQX2limwVRKGo+T",
+	"udKM46dqzniYDBOGGas05L5BzleIKQ1VwVeQO/xpsFqACXjwQxGi3APDS3A9p6zk+gxyNnN9sGZSdu9t",
+	"6I3We8LeetiwU9+h8Ss556KAHLtXtaVFwGkSylN2OJ26f56n7PDRQcoODx4jyIcHz1PGNTCpbJiUe26X",
+	"IHQzCddgyWXuhuALLuSEnYI+B81Aa6WpAX2dMiNkBr1Jl3zFlvwcPG3kDSHMYK40MGE9/EQDwq0eTTpJ",
+	"E8lLSI66q73nlrtLKiW/fAVyYZfJ0cGTJ2lSChn+fpSuEVKanMyRzNdJ5ntZrBivqmLVg1/QGsb3EjNW",
+	"FAWDTzUvDL5YiHOQzG1N15RLBlwXAhw6+cYp+u23cRukySu3XU6hgMwqHSF7VZacGXCbxXGAQhjr4MBd",
+	"hlMSGkqQjih4UbhXREJljUtls+WEndZVpbT7vPsBLvPHM1j92zkvaviY4h9/6P31kf0LjQSXwljzR6Sm",
+	"j3/ovskVGKQ3bPLHDkY+1aBXLUKK3ky3YcXYl+cg7UkeZ4gibxaOG8sM0u8ebnNw3+GrjHiGhgzEuSNm",
+	"99BYDbxkGkxdgmF8bkEzYSdj6+hA2UNY9k7+so2n0Uti5m4vuR+VVhVoKwAfZyqHyMdpUoIxfAFxfPh1",
+	"y5OjH6mHtv37Zk+o2c+Q2SQQFQ7H81w4tPHiTQ+M4SZbA6eP8mM9E1ZzvXJMbh8phJVgec4tZ9xani2J",
+	"UTZs1eGz5JfdUZ8exkCl5i8KJeEt7dB1pMGlJ4SKWwvaQfS/P/K9/zve+5/p3vP9vff/mkSmIGRW1Dm8",
+	"I/ZEdDTndWGTozkvDDSfzJQqgEv3TdFg7h81zJOj5B/2W3G975d33+N3uDIE5vsNs9TAHUZHJ8rzXIMx",
+	"6zT/LVwykG7pc8azTNXSMt84pb2Okt8yqxZgl6BJNmVLLuRJPmEvlpCdmbosvUQo1AXojBtgpoKiEHJh",
+	"PHvzvSJ3QDZIO3oNu77rCMtyL7o89Z9NA3IhzsEwJTfB7AFwBDRXuuQ2OUqEtE8PE5QFoqzLriQQ0sIC",
+	"tAPpy8jkekue0tbhBvLXSmbwws2rR1uOzEt+SXA+Pnj29Nk2uI3l2ro+hVz8Vauy192009vzg4PHj58d",
+	"TB8//fOTw2fPnk6n007f0/W+owQan8JOZEsqy4YNur4J8+0cTTiY/OZpR0Weuz4UjwtKJxQyXhSgmWPc",
+	"OfM0+N97x+4Dr3YFoUGqS8q4YdwYQNk4IzWBOplESd7hAfJjhKqhzZxb2LOihNgnDWLWocVXASDSoVKm",
+	"nM5igJRVekgCzUQh8jtsBPfSrW58cHx1vcHXNmOEkOFTDzXjLenJli3XoYN3rv2QbtrZ09C+2+5CbaBq",
+	"7PeVMHYDWZ8HK01YKM11AG4nmXCt+Wp9L1Lf2wB8t6oiS+hn/qEQpbAfeFVpxbOlMxGc/UGKa6lKkJY9",
+	"n/5TWOfIth+qvzPIeAlu89QGiPODrMsOtj945CYN9X0gm7L7ZA0utzZIUB/QFM3bvzUMn2SFGrSoCp51",
+	"n1jNpZmDdqh8P9wWaXK552DeO+fasQLjgB+itMvZsOeRBj80Uxtp8MrN9Lg30WFLUkBehVnGX7+FYnOD",
+	"F4Xa9Ppti6R4g3ddnLVE9jfYQP+j6sirRn1Yjismky1qww5sgkihL2gjrX4BX95NYF1fSygiKsI65CO6",
+	"RLQhNvkvp3rHmyCtVzyLmxcSLu1rJXtvOx+HXbgNjpiqst7K29axl+OivwtjBH9RGOMYHKIrQkctjM3S",
+	"djHY2SCbJYRneNeWEd1ttyYpCBMvam2iLgF8Hhi3a8kqdMrxGdq/SrZ2ceUtsc36VzOJDcLo1HJrxvGw",
+	"20bdjcY8OF9j2+xG1nF0BELZlRIjNHZNPZuEzahx+ItsU/9xbHQEbqMcuJ4Wy5aqyINXFhXNgWpJyEM7",
+	"lJBGrUZUXGx8Hd6+o1K8iz5rua0JA0EJCkK6UVI6+ksta/ohnfP8gzCmjikom9RY6dmfHzm2XG+0mhVQ",
+	"ri/HsWRv//qCPfvz9BmrqFFKnm9v2uyfHzCtaguGCclQX/BGPzqrSN3rr3wOlotifayXl1XBJVqFtPjC",
+	"MJVltdbQMSw8FJO4d8ZYHrVP3nC7HHjaN1DG0AofEmixWeP1GCJPvlIfSi5XXkf9EIIkUdroUVlkWHrd",
+	"nUTQiyCPTmfUrzDsersa/8WTivPLdRDW230tCNott/7OCltE6OW0LkvnmvQQnAmJDGkT8dmoVXVCESUB",
+	"JtYR44bVWh7lAjfpkX989Nkpv1fkXHY/2QwC81Oyv8FQ1+Fl5WaRxPoaQdVWCe7tXkLQRu5BxsA3dXFG",
+	"0ubEEivZ1Z/juoYuR9Qw5IlbGV7Q96ivXaAclYm2de7upH5Fp0+b74S+fxScauHvLXZ8gGC3aYxJWBeO",
+	"KH7BPN7i91udDmGYHYF1XV6DNlRtM1VuoY404YUGnq9aO1+qD6bOlp7ad6efMN74bL4Vxiq9eimtXkVd",
+	"id5K6fMBDX7/eW8K9/FzDBuT54yCbBfcsCUUPtyr0IdNk/DB0JR1XBVNb74J9WGVOmPKRV9t1y/HWXB7",
+	"NO45936udAYfWKVhLi7R5HYwk7c+hO9zJYEgYjwvhRy4ccJ6NLPsKzHNqtBQnTf0oKP4tG6ZTf6Yq/TX",
+	"9NleR92rtJCZqHgRB/X4zQlmD7TAIAGUPAcUdAjaGayO2FwVLsDSwEfZCI8O0FuSi4WwTSrB6bfHewdP",
+	"nrqnYBopegarCTueNWkLYWmbsVxaAJcBpJEQjVOQjeVltavuPNhnQRn1+6Tb4dYtt8GYkFb7n9dgdb2d",
+	"HLGYx7nSJnNggyYemEwAd3zGqIxdh0/emEkTV6dgmwHj9/G1DJWAnq7BAtuQ9BZ+BqKl0bh4gHNcE5KK",
+	"nMh8VsCO3t8YCC9UDu+U+o7LFb7I3zUjjDV/reyrZuANNDcirnCKWzE0ouhkSlqxqFUdDWQPvLMkrSSw",
+	"9iumQyLXXINZeos7cFylPaNFWM2E/ZWLwieCHR4chJyZYNQsuWlMgNDTBRcW9V7FZtCY+AsuZMqUdj2Q",
+	"D0CVjXlIg6Hs8jqB/67HzzrRefqgxzlGVPuWMVRcW8GLXbGGDF2u+h4NTHLbMYLCHes36Shm37UT92l7",
+	"qi5yzKDp4E0D02BrLd0fDtmOVlBCGgsczRKXZLWW+/aCy9BVpsqZkD6NrEMJceTGaNZsp9ZRv6h7fV0e",
+	"j33G1rAUxjmcXzbLH7NIEQONuvTSo7iVlU6KnbsFCU4nhBHzfnYmpi/wy7KLJUjM2+pl8O3urHULQ6u/",
+	"wQMXiOkCfPafJySnBjK/BehZ16Vy3bVp+XfM0BihlBB42p7ds5t30fIzh8m+yrzdyT2enENgbjE0v4bd",
+	"OyYjMXKe1VrY1alDOo3YRCOOK+FTYnFJcNsC15ik5ntaWltRBpqQcxXBJU7RoY0yPSfMu5x9smemqpC/",
+	"1QxLrMr7C5t91L73RkiTMOTW4qNnsR+73bwNya39/pldcouixGuxxjGpuVjUaCvVxrKMa70KXhS7JB8M",
+	"ZzR7ZtUZyE73wzRZzFR1w3VsBPdzhXNGVVpJNoMlL+ZugKj1kTJuWamMZQdPnrACrAVtUq/EpwxnYhxT",
+	"mXz496P9P+05KDTPXCtCINo+TIwZLegFdxs2cxlaQWQai7nUHjFpz7lOijDTkCmdGyYs8RqrvMvV2zAs",
+	"B+2yHtlcqxJf9Y0F8qWtUUYnMnaUPJpMJ1O07CuQvBLJUfJ4Mp08TpxYtUsk0/1uCGwBEYfh38D2JGST",
+	"NU2ptbgxBwTp2CVh6m8v37FmiH33nOB3exPdzyc5DeG//2b10qtb3fzyHz9HU1KDZtZuUqtr2JTj+d41",
+	"9onb7v3BdBq0M5+t5BKORYag7f9sSNVt+7tuaPBqLRXTt/LZ3OfIgCI1BLGBfLN9bHN1hZ1XykSWjPIi",
+	"2mxOVOEWfh0x0ddRzNqCYho88WwxRwoOOxrxQfnI3rvaqCwUsGrS1p2wakz+7texdSc4PUr8QoKx36h8",
+	"9bWXZZi7eXV1NSScq5snjrVUvA0UEhJ1rtLk8CtCQonNbthuH96D/afr9RViWZFZzHgeaIRm8PiuzQDz",
+	"7BpphylRzjmHVI5WAq1Q0OGbxGmc7eHdnm2/KsDP6fldm9PxRhY3Q30oF/M5aKxN6vG6YNEiAswkIXbb",
+	"irLZaq+TXrWT4KRQkpJ7mEQV0q28mkF9MeF4bllxp0Nl3MCekAakEc72KVaTjZLz2MOzk+wMiVybpOe1",
+	"8rev0vhAvIHqdyqm7wf7vnMM7bXqlghqWAhjIZQJtuqr34syb9MeXV+GgtJ+F4eOZqv19kmfLzgVd5Qj",
+	"uGS00Jkh3xKpyciRUqYqKvYpVmwuChvecl8vZnz1FbGMTBUFmfNMELMeUbtDgSm5/bxXCpmhkIuix5+C",
+	"Zue2qWeTDS/p1BJSQGRQgnf85uSodZQsQQO7QN+YGxG1yYPptK3pFNKqxja8UMwvKaJ2poGfdWrADFuA",
+	"BCqhQ3tIOMVTnAEzlqMdR1Vh+G3DmFOEButqvU+tFMbyM5C+VLRxiAcMCcM4++GHk7/ElFW3eMHKWeex",
+	"MSptm+z36wTHeCUqGb0itcbn+WjarSR51C8duQY7zsj99Stz315WZlRyV54im90iusSrOsQxuQ8aco/l",
+	"9NjEgL8gwY8ymOPFQsOCW+8zcfoFaCoNK4oWl2jIuW3KZcNzBjxmg6aBuaS/dAfcApH1c143CHmHU8e3",
+	"MtOR9/ePqIaTXaMsx0NHSesUXyNdUe2Loyo6ZcB30ZIV+cS75ISs2fm5RN44Hs163S8GXlzZZ8c/YYIl",
+	"7Jh0Ad0qYGGYqkCSPMQOXP/CEF3zTzWwrOfWr5QRtiOz/DdyMC3bNYYwFw4BEpbNeHbmIO+VFTfVyB3Q",
+	"msJk9rLptpE45LX1Qxv4RKpAGiBo3iovcIWmRBSK5BMuEfOqwPCUBAYyh3zCjl3sqwLZa+9nlHPLZ9xA",
+	"F8ciLzDUaFJM+zU0wc48amlF4WZOA0TkIhFGkIw02xuSj6iRvEHy2VyBvsN4bYX6DrzIwqXdx9XZa7fJ",
+	"xlLyoTzz6FRz1q3vYf9x+v1rRoEEkzLg2bI5AIRLdnr60pMoJdgEojLMjXg/BF+fCX1udLKrDicak0Qj",
+	"pq5zbneVqjbt4cEEfTBBb9enNhI1Efm6j41O1YmeheLLA1vB2pcnaRs8c8whGGaU9rHyPq42kkR5/hb5",
+	"/ho7p0DqLW2wG3P398PBv46zf8f93ZbfPuzu+7y7u8o4kWf4PBmVgftZoSTl58QDfapa9Uw9ijUPk7uc",
+	"wtjxLXUzo/pnaUmK8cNFeDRhf/epA+EDMryVpDQyqUKqSpuY0yTJYMaYY0hDeHydsfHfTtgLVYn2S3YG",
+	"UOFAIVpOGqzQzKhaZ+AP+HIRdAdBT3EXmqkLmXbgRDDaeLthg1J4+j4an3Tf33VW2Dub5zfNCXG5HgKe",
+	"DwHP3yD7vi8B0E7STiTG2ZVRyDd2EFHtKSvjMY+25sIME257ibaori55VYF0YuElOjlwAJQ23DlLapAZ",
+	"MFmXM9Ct397BukB+7nrhslvAZzGxCs6FS6Sm3obljpmSzoWjDau4MW1S53A8BLDSKsNCUDTT0Sa3ilJ0",
+	"Ze0jJBB3lnSCCGOekq8mVsYCsg7geJBhuunMrunuQYavGce4BUN8/SihEX7hFQ2qDwp8kHbUkFB6KeD3",
+	"L17x+7DHR3ke6bH7n/H/q60ZIFTQ2y1AHPKf/hl6kcMYhKWcPCaC1txq3BN2PFCxmdhc0cElo9MO2uYz",
+	"cBK+yX2PgdmkETvns6+TbuonA8AuE9emFCFneJICo5MUQuklJywvuXMoM9fdCsayQsNxMjfNIvt9haKo",
+	"L8mMmd4yC1s7/WOE7lsSbOtUhXlgUfeWRX1JOK+710fCc3iClNv4DXT+czKwmyBFJ4TkdrzyGt0wUIfN",
+	"BqKzG5v7hcG3beGq29DBHiJRD/ziDvCLzlEcG3UZ3y5qUZqhu9CX8fEmnWfgeuwZgiYksPkqyRTD28YX",
+	"w1HS2WiOnLHcQuqbWlGCr8yRGVDtbK90U80bKLaWGK5Vc/bKGUcUl7Ya+Zatuy7eexbYrlWSV+lw5V0x",
+	"sa8lnh6yWhZgjM8/CEdh9Gmht/bC2Uu1zCcj58rzovhev1bWn74ZMRlHTvsewwBSQq+nLymejxaitwv7",
+	"xq2qcRlG0JwMGn3bnPsZfdsc+/k+gne8AKFA9wl92Cjx1tFoK2cD1ksYQ7I/smDN8t/tVImdAfNXSFwH",
+	"pm/wky8CKtZpKWSwGb5UWx/rmV/eUM/3P+szVnM+4q70h6h4utpgoTyES++uptLXS7A6sSsG3LIbsCjB",
+	"uwdObFQ9QqQC77YZPy/gJ7kl0QKbNZ/RaRJhYE4peaRKUNzen3/hMAf5wI3Dhxn2ITFjVpcVRSj9GVht",
+	"LiX1SoTvb30ZHkXRLb+MaB8UW74VBWSLaROu9rmpAOfYMXO3HOQcPSZuZBcEwsHDHjzlPFhQD3HAG5yj",
+	"UsTIMiVD8ldzZ5mS6+HcRwd3eRkDm20Wr6SL9JbQ3qrn73FLNlTRt+cwKd2TA+tRPdeS2MCvy3D7t/Bd",
+	"h0WvKfmnkCmZ46kfLpLQuVRkzXKnCEMTgWhFLp6dY8Mpvr4cDC4zcFLNmth5TGnsjCThLj4S3EKxCoda",
+	"PZ+wv/sAR0PJlDevz0lmNvKSay3Ow0VIMe3YzW94h52//2e6i2v/piRb70ixX0WobVXZqZlfX5556O6J",
+	"LLvPfJ6U7pGrMEXLR+iuTjpxXKsFVsj+JH9vQiJNDg/u5GyHK+liMRj39ZelNqcfeEJAspAqevJgSN6k",
+	"e2rbo/4QO4/vtN7HDat4bZqbX0m8K506XASp15t4WxgljA9BeSX+4PmdRMW6hLY7HlZPjvbWOgcdVwaE",
+	"M+7xUydwyRLvVSh63z12k2yNVOx/7viZN1YL3YZmFumrA95vpvRoV7HeSUDhZtyd0ubf3Yib7k4ak/GY",
+	"CGVcNsJmi5dq4KMad/DcGaK+UePlRrXwL/AtHY4d4RjScHzND7JOYRgIdBo2TLMNXV6466zRuOLGiIXs",
+	"pX09+Izu2DYPm/vBuHgwLn6HxsVOCSh9tW7fV4BtTEuhyAnG/cPNDv0zh2ObtMkuwbwSd8U2l+H8Um6t",
+	"FrPatuoMHQNrOqdFxG+5aEiebvHnOUbDS1KS1854VfPuObEbU0n8BQoPSuzwSoltamy/hDBQxEPq6x0K",
+	"EKNPwm1ytuQ545t29ZewmHD9zXiZ7XfqHIb3+3hVzcPS1M3S3ymDyWLi+WD3KBZKOMKjl5ixdXbGlPTx",
+	"5ObmIRcQNu39Qcg5ltDpvVMD0BetdJY6ewtWr3AM2Uam/SQ92Zv2oyYuPuQ94eT3+2dp3IrdMDw4/zfq",
+	"wG98P2oeIbP7xSTf9QRA554F2qcDtuIwMVIoeb+tlodY972NdXcvq3BM3HHLtZsqfnzvOCNVfxCr74/v",
+	"0xPbYvEkTWpdJEfJfiSQDJcOFSLavHnkaunC76skTc65FnxWDK7S6OVGNr+uIimqayCmDNzidA5X44aN",
+	"rlID4PnBTjP64t6/GgbeX/3/AFEbBp2akAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -324,16 +1366,16 @@ var swaggerSpec = []string{
 func decodeSpec() ([]byte, error) {
 	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
 	if err != nil {
-		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
 	}
 	zr, err := gzip.NewReader(bytes.NewReader(zipped))
 	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %s", err)
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
 	}
 	var buf bytes.Buffer
 	_, err = buf.ReadFrom(zr)
 	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %s", err)
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
 	}
 
 	return buf.Bytes(), nil
@@ -351,7 +1393,7 @@ func decodeSpecCached() func() ([]byte, error) {
 
 // Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
 func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
-	var res = make(map[string]func() ([]byte, error))
+	res := make(map[string]func() ([]byte, error))
 	if len(pathToFile) > 0 {
 		res[pathToFile] = rawSpec
 	}
@@ -365,12 +1407,12 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 // Externally referenced files must be embedded in the corresponding golang packages.
 // Urls can be supported but this task was out of the scope.
 func GetSwagger() (swagger *openapi3.T, err error) {
-	var resolvePath = PathToRawSpec("")
+	resolvePath := PathToRawSpec("")
 
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
 	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
-		var pathToFile = url.String()
+		pathToFile := url.String()
 		pathToFile = path.Clean(pathToFile)
 		getSpec, ok := resolvePath[pathToFile]
 		if !ok {
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+// HeaderIdempotentReplayed marks responses replayed for a retried idempotency key.
+const HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+const idempotencyKeyTtl = 24 * time.Hour
+
+// idempotencyKeyWriteTimeout bounds storing the outcome of a request under its idempotency key, which can not use the
+// context of the request as the client may have gone away, the very case idempotency keys are for.
+const idempotencyKeyWriteTimeout = 10 * time.Second
+
+// bodyRecorder copies the response body written through it.
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+
+	return r.ResponseWriter.Write(b)
+}
+
+// idempotent handles the request with next unless the idempotency key was already used for it, in which case the
+// stored response is replayed. Requests without an idempotency key are always handled.
+func (h *Handler) idempotent(ctx echo.Context, lineageId string, key *api.IdempotencyKey, next func() error) error {
+	if key == nil {
+		return next()
+	}
+
+	req := ctx.Request()
+	rCtx := req.Context()
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	digest := sha256.New()
+	digest.Write([]byte(req.Method + " " + req.URL.Path + "\n"))
+	digest.Write(body)
+	fingerprint := hex.EncodeToString(digest.Sum(nil))
+
+	stored, err := h.servicer.ReserveIdempotencyKey(rCtx, lineageId, *key, fingerprint)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest, ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		case ticket.ErrIdempotencyKeyInProgress:
+			return respondError(ctx, http.StatusConflict, ErrorCodeIdempotencyKeyInProgress, err.Error())
+		case ticket.ErrIdempotencyKeyReused:
+			return respondError(ctx, http.StatusUnprocessableEntity, ErrorCodeIdempotencyKeyReused, err.Error())
+		default:
+			return err
+		}
+	}
+
+	if stored != nil {
+		ctx.Response().Header().Set(HeaderIdempotentReplayed, "true")
+		if len(stored.Body) == 0 {
+			return ctx.NoContent(stored.StatusCode)
+		}
+
+		return ctx.Blob(stored.StatusCode, stored.ContentType, stored.Body)
+	}
+
+	recorder := &bodyRecorder{ResponseWriter: ctx.Response().Writer}
+	ctx.Response().Writer = recorder
+
+	err = next()
+
+	wCtx, cancel := detachedContext(rCtx, idempotencyKeyWriteTimeout)
+	defer cancel()
+
+	if retryable(ctx, err) {
+		if releaseErr := h.servicer.ReleaseIdempotencyKey(wCtx, lineageId, *key); releaseErr != nil {
+			log.Ctx(rCtx).Error().Err(releaseErr).Msg("can not release idempotency key")
+		}
+
+		return err
+	}
+
+	// the request may have changed tickets before failing, its error response is stored and replayed like any other
+	// rather than handling retries from scratch
+	if err != nil {
+		log.Ctx(rCtx).Error().Err(err).Msg("request with idempotency key failed")
+		h.e.HTTPErrorHandler(err, ctx)
+	}
+
+	resp := &ticket.IdempotentResponse{
+		StatusCode:  ctx.Response().Status,
+		ContentType: ctx.Response().Header().Get(echo.HeaderContentType),
+		Body:        recorder.body.Bytes(),
+	}
+	if err := h.servicer.CompleteIdempotencyKey(wCtx, lineageId, *key, resp, idempotencyKeyTtl); err != nil {
+		log.Ctx(rCtx).Error().Err(err).Msg("can not store idempotent response")
+	}
+
+	return nil
+}
+
+// detachedContext returns a context with the logger and namespace of ctx which is not cancelled with it, but once
+// timeout expired.
+func detachedContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	detached := log.Ctx(ctx).WithContext(context.Background())
+	detached = ticket.WithNamespace(detached, ticket.NamespaceFromContext(ctx))
+
+	return context.WithTimeout(detached, timeout)
+}
+
+// retryable reports whether a request failed before changing any ticket in a way a retry may overcome: it was
+// invalid, lost the optimistic lock, did not match If-Match, hit a paused lineage or the lease limit.
+func retryable(ctx echo.Context, err error) bool {
+	if err != nil {
+		he, ok := err.(*echo.HTTPError)
+
+		return ok && he.Code < http.StatusInternalServerError
+	}
+
+	switch ctx.Response().Status {
+	case http.StatusBadRequest, http.StatusConflict, http.StatusPreconditionFailed, http.StatusLocked,
+		http.StatusTooManyRequests:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+const leasePath = "/lineages/" + testLineageId + "/tickets"
+
+func TestIdempotent_Replay(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.leaseTicket = leased
+	h := newTestHandler(t, servicer)
+
+	first := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+	assertStatus(t, first, http.StatusOK)
+
+	second := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+	assertStatus(t, second, http.StatusOK)
+
+	if second.Header().Get(HeaderIdempotentReplayed) != "true" {
+		t.Errorf("expected replayed response to be marked by %s", HeaderIdempotentReplayed)
+	}
+
+	if second.Body.String() != first.Body.String() {
+		t.Errorf("expected replayed body %s, got %s", first.Body.String(), second.Body.String())
+	}
+
+	if servicer.leaseCalls != 1 {
+		t.Errorf("expected a single lease, got %d", servicer.leaseCalls)
+	}
+}
+
+func TestIdempotent_FingerprintMismatch(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.leaseTicket = leased
+	h := newTestHandler(t, servicer)
+
+	assertStatus(t, serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key"),
+		http.StatusOK)
+
+	rec := serve(h, http.MethodPost, leasePath, `{"extIds":["tx2"]}`, "Idempotency-Key", "key")
+	assertStatus(t, rec, http.StatusUnprocessableEntity)
+	assertErrorCode(t, rec, ErrorCodeIdempotencyKeyReused)
+
+	if servicer.leaseCalls != 1 {
+		t.Errorf("expected a single lease, got %d", servicer.leaseCalls)
+	}
+}
+
+func TestIdempotent_InProgress(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.leaseTicket = func(ctx context.Context) (*api.TicketLeaseResponse, error) {
+		h := newTestHandler(t, servicer)
+		rec := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+
+		assertStatus(t, rec, http.StatusConflict)
+		assertErrorCode(t, rec, ErrorCodeIdempotencyKeyInProgress)
+
+		return leased(ctx)
+	}
+	h := newTestHandler(t, servicer)
+
+	assertStatus(t, serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key"),
+		http.StatusOK)
+}
+
+func TestIdempotent_Retryable(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{ticket.ErrInvalidRequest, http.StatusBadRequest},
+		{ticket.ErrTooManyConcurrentRequests, http.StatusConflict},
+		{ticket.ErrLineageVersionMismatch, http.StatusPreconditionFailed},
+		{ticket.ErrLineagePaused, http.StatusLocked},
+		{ticket.ErrTooManyLeasedTickets, http.StatusTooManyRequests},
+	}
+
+	for _, test := range tests {
+		t.Run(test.err.Error(), func(t *testing.T) {
+			servicer := newStubServicer()
+			servicer.leaseTicket = failing(test.err)
+			h := newTestHandler(t, servicer)
+
+			rec := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+			assertStatus(t, rec, test.status)
+
+			if _, ok := servicer.keys["key"]; ok {
+				t.Errorf("expected idempotency key to be released")
+			}
+
+			servicer.leaseTicket = leased
+			rec = serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+			assertStatus(t, rec, http.StatusOK)
+
+			if rec.Header().Get(HeaderIdempotentReplayed) != "" {
+				t.Errorf("expected retry to be handled instead of replayed")
+			}
+
+			if servicer.leaseCalls != 2 {
+				t.Errorf("expected retry to lease again, got %d leases", servicer.leaseCalls)
+			}
+		})
+	}
+}
+
+func TestIdempotent_Failure(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.leaseTicket = failing(errors.New("connection reset"))
+	h := newTestHandler(t, servicer)
+
+	first := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+	assertStatus(t, first, http.StatusInternalServerError)
+
+	// the failed lease may have leased the ticket, its retry must not lease another one
+	servicer.leaseTicket = leased
+	second := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+	assertStatus(t, second, http.StatusInternalServerError)
+
+	if second.Header().Get(HeaderIdempotentReplayed) != "true" {
+		t.Errorf("expected failure to be replayed")
+	}
+
+	if servicer.leaseCalls != 1 {
+		t.Errorf("expected a single lease, got %d", servicer.leaseCalls)
+	}
+}
+
+func TestIdempotent_ClientGone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	servicer := newStubServicer()
+	servicer.leaseTicket = func(ctx context.Context) (*api.TicketLeaseResponse, error) {
+		cancel()
+		return leased(ctx)
+	}
+	h := newTestHandler(t, servicer)
+
+	serveContext(ctx, h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+
+	stored, ok := servicer.keys["key"]
+	if !ok || stored.resp == nil || stored.resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the response to be stored although the client went away")
+	}
+
+	rec := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+	assertStatus(t, rec, http.StatusOK)
+
+	if rec.Header().Get(HeaderIdempotentReplayed) != "true" {
+		t.Errorf("expected retry to be replayed")
+	}
+}
+
+func TestIdempotent_ClientGone_Retryable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	servicer := newStubServicer()
+	servicer.leaseTicket = func(context.Context) (*api.TicketLeaseResponse, error) {
+		cancel()
+		return nil, ticket.ErrTooManyConcurrentRequests
+	}
+	h := newTestHandler(t, servicer)
+
+	serveContext(ctx, h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`, "Idempotency-Key", "key")
+
+	if _, ok := servicer.keys["key"]; ok {
+		t.Errorf("expected idempotency key to be released although the client went away")
+	}
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+var namespacePathRegexp = regexp.MustCompile(`^/namespaces/([^/]+)(/lineages(?:/.*)?)$`)
+
+// NamespaceCredentials maps the known namespaces to the hex encoded SHA-256 digests of the API keys granting
+// access to them. Namespaces without API keys are accessible without authentication.
+type NamespaceCredentials map[string][]string
+
+var ErrUnauthorized = errors.New("missing or invalid API key")
+
+// keyPrincipalLength is the number of hex digits of the key digest a principal is made of, enough to tell the keys
+// of a deployment apart.
+const keyPrincipalLength = 12
+
+// namespaceRewriter scopes the request to a namespace. Requests to /namespaces/{namespace}/lineages/... are
+// rewritten to their /lineages/... counterpart, every other request is scoped to the default namespace.
+func (h *Handler) namespaceRewriter(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+
+		namespace := ticket.DefaultNamespace
+		if m := namespacePathRegexp.FindStringSubmatch(req.URL.Path); m != nil {
+			namespace = m[1]
+			req.URL.Path = m[2]
+			req.URL.RawPath = ""
+		}
+
+		ctx.SetRequest(req.WithContext(ticket.WithNamespace(req.Context(), namespace)))
+
+		return next(ctx)
+	}
+}
+
+// namespaceAuthenticator rejects lineage requests to unknown namespaces and requests without a valid API key
+// to namespaces which require one.
+func (h *Handler) namespaceAuthenticator(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		if !strings.HasPrefix(req.URL.Path, "/lineages") {
+			return next(ctx)
+		}
+
+		namespace := ticket.NamespaceFromContext(req.Context())
+		key := BearerToken(req.Header.Get(echo.HeaderAuthorization))
+		switch h.credentials.Authorize(namespace, key) {
+		case ticket.ErrNoSuchNamespace:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, ticket.ErrNoSuchNamespace.Error())
+		case ErrUnauthorized:
+			return respondError(ctx, http.StatusUnauthorized, ErrorCodeUnauthorized, ErrUnauthorized.Error())
+		}
+
+		if principal := h.credentials.Principal(namespace, key); principal != "" {
+			ctx.SetRequest(req.WithContext(ticket.WithPrincipal(req.Context(), principal)))
+		}
+
+		return next(ctx)
+	}
+}
+
+// Authorize checks the API key sent for a namespace. It returns ticket.ErrNoSuchNamespace for unknown namespaces
+// and ErrUnauthorized if the namespace requires an API key and key is not one of them.
+func (c NamespaceCredentials) Authorize(namespace string, key string) error {
+	keyDigests, ok := c[namespace]
+	if !ok && namespace != ticket.DefaultNamespace {
+		return ticket.ErrNoSuchNamespace
+	}
+
+	if len(keyDigests) > 0 && !IsAuthorized(key, keyDigests) {
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+// Principal returns the principal of a request authorized for a namespace: the fingerprint of its API key if the
+// namespace requires one, an empty string if the namespace is open to anonymous callers.
+func (c NamespaceCredentials) Principal(namespace string, key string) string {
+	if len(c[namespace]) == 0 {
+		return ""
+	}
+
+	return KeyPrincipal(key)
+}
+
+// KeyPrincipal identifies an API key in the ticket history without revealing it, by the first characters of the hex
+// encoded SHA-256 digest operators configure it with.
+func KeyPrincipal(key string) string {
+	digest := sha256.Sum256([]byte(key))
+
+	return "key:" + hex.EncodeToString(digest[:])[:keyPrincipalLength]
+}
+
+// BearerToken returns the token of a bearer authorization, an empty string for any other authorization.
+func BearerToken(authorization string) string {
+	if token, ok := strings.CutPrefix(authorization, "Bearer "); ok {
+		return token
+	}
+
+	return ""
+}
+
+// IsAuthorized reports whether key is one of the API keys given as hex encoded SHA-256 digests.
+func IsAuthorized(key string, keyDigests []string) bool {
+	if key == "" {
+		return false
+	}
+
+	digest := sha256.Sum256([]byte(key))
+	encoded := []byte(hex.EncodeToString(digest[:]))
+
+	authorized := false
+	for _, d := range keyDigests {
+		if subtle.ConstantTimeCompare(encoded, []byte(strings.ToLower(d))) == 1 {
+			authorized = true
+		}
+	}
+
+	return authorized
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+)
+
+// MIMEApplicationProblemJSON is the content type of the errors sent by the /v2 routes.
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// ProblemTypePrefix prefixes the error code in the type of a problem.
+const ProblemTypePrefix = "urn:dinonce:problem:"
+
+var v2PathRegexp = regexp.MustCompile(`^/v2(/(?:lineages|namespaces)(?:/.*)?)$`)
+
+type problemPathContextKey struct{}
+
+// versionRewriter rewrites requests to /v2/... to their unprefixed counterparts, remembering the original path so
+// that their errors are sent as problems.
+func (h *Handler) versionRewriter(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+
+		if m := v2PathRegexp.FindStringSubmatch(req.URL.Path); m != nil {
+			path := req.URL.Path
+			req.URL.Path = m[1]
+			req.URL.RawPath = ""
+			ctx.SetRequest(req.WithContext(context.WithValue(req.Context(), problemPathContextKey{}, path)))
+		}
+
+		return next(ctx)
+	}
+}
+
+// problemPath returns the original path of a /v2 request.
+func problemPath(ctx echo.Context) (string, bool) {
+	path, ok := ctx.Request().Context().Value(problemPathContextKey{}).(string)
+
+	return path, ok
+}
+
+// respondError sends an error with the given code, as an Error to v1 requests and as a problem to v2 requests.
+func respondError(ctx echo.Context, status int, code string, message string) error {
+	if _, ok := problemPath(ctx); !ok {
+		return ctx.JSON(status, api.Error{
+			Code:    code,
+			Message: message,
+		})
+	}
+
+	return respondProblem(ctx, newProblem(ctx, status, code, message))
+}
+
+// respondEmptyError sends an error with the given code as a problem to v2 requests, and without a body to v1 requests,
+// for the v1 responses that never had one.
+func respondEmptyError(ctx echo.Context, status int, code string, message string) error {
+	if _, ok := problemPath(ctx); !ok {
+		return ctx.NoContent(status)
+	}
+
+	return respondProblem(ctx, newProblem(ctx, status, code, message))
+}
+
+// respondTooManyLeasedTickets sends a too_many_leased_tickets error. Problems carry the counters of the lineage at
+// the time of the response.
+func (h *Handler) respondTooManyLeasedTickets(ctx echo.Context, lineageId string, message string) error {
+	if _, ok := problemPath(ctx); !ok {
+		return respondError(ctx, http.StatusTooManyRequests, ErrorCodeTooManyLeasedTickets, message)
+	}
+
+	problem := newProblem(ctx, http.StatusTooManyRequests, ErrorCodeTooManyLeasedTickets, message)
+
+	lineage, err := h.servicer.GetLineageById(ctx.Request().Context(), lineageId)
+	if err != nil {
+		log.Ctx(ctx.Request().Context()).Error().Err(err).Msg("can not get lineage counters of problem")
+	} else {
+		problem.LeasedNonceCount = &lineage.LeasedNonceCount
+		problem.ReleasedNonceCount = &lineage.ReleasedNonceCount
+		problem.MaxLeasedNonceCount = &lineage.MaxLeasedNonceCount
+	}
+
+	return respondProblem(ctx, problem)
+}
+
+// errorHandler sends the errors returned by handlers and middlewares, in the format of the version of the request.
+func (h *Handler) errorHandler(err error, ctx echo.Context) {
+	if _, ok := problemPath(ctx); !ok {
+		h.e.DefaultHTTPErrorHandler(err, ctx)
+		return
+	}
+
+	if ctx.Response().Committed {
+		return
+	}
+
+	status := http.StatusInternalServerError
+	message := http.StatusText(status)
+	if he, ok := err.(*echo.HTTPError); ok {
+		status = he.Code
+		message = fmt.Sprint(he.Message)
+	}
+
+	code := strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+	if err := respondError(ctx, status, code, message); err != nil {
+		log.Ctx(ctx.Request().Context()).Error().Err(err).Msg("can not send problem")
+	}
+}
+
+func newProblem(ctx echo.Context, status int, code string, message string) *api.Problem {
+	path, _ := problemPath(ctx)
+
+	problem := &api.Problem{
+		Type:     ProblemTypePrefix + code,
+		Title:    problemTitle(code),
+		Status:   status,
+		Detail:   &message,
+		Instance: &path,
+	}
+
+	if lineageId := ctx.Param("lineageId"); lineageId != "" {
+		problem.LineageId = &lineageId
+	}
+
+	return problem
+}
+
+func respondProblem(ctx echo.Context, problem *api.Problem) error {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(problem.Status, MIMEApplicationProblemJSON, body)
+}
+
+// problemTitle turns an error code like too_many_leased_tickets into the title "Too many leased tickets".
+func problemTitle(code string) string {
+	if code == "" {
+		return ""
+	}
+
+	title := strings.ReplaceAll(code, "_", " ")
+
+	return strings.ToUpper(title[:1]) + title[1:]
+}
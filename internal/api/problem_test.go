@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+const ticketPath = "/lineages/" + testLineageId + "/tickets/tx1"
+
+func TestProblem_GetTicket_NotFound(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.getTicket = func(context.Context) (*api.TicketLeaseResponse, error) {
+		return nil, ticket.ErrNoSuchTicket
+	}
+	h := newTestHandler(t, servicer)
+
+	rec := serve(h, http.MethodGet, ticketPath, "")
+	assertStatus(t, rec, http.StatusNotFound)
+	assertEmpty(t, rec)
+
+	rec = serve(h, http.MethodGet, "/v2"+ticketPath, "")
+	assertStatus(t, rec, http.StatusNotFound)
+	assertProblem(t, rec, http.StatusNotFound, ErrorCodeNotFound, "/v2"+ticketPath)
+}
+
+func TestProblem_UpdateTicket_NotFound(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.releaseTicket = func(context.Context) error {
+		return ticket.ErrNoSuchTicket
+	}
+	h := newTestHandler(t, servicer)
+
+	rec := serve(h, http.MethodPatch, ticketPath, `{"state":"released"}`)
+	assertStatus(t, rec, http.StatusNotFound)
+	assertEmpty(t, rec)
+
+	rec = serve(h, http.MethodPatch, "/v2"+ticketPath, `{"state":"released"}`)
+	assertStatus(t, rec, http.StatusNotFound)
+	assertProblem(t, rec, http.StatusNotFound, ErrorCodeNotFound, "/v2"+ticketPath)
+}
+
+func TestProblem_UpdateTicket_Errors(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.closeTicket = func(context.Context) error {
+		return ticket.ErrLineageVersionMismatch
+	}
+	h := newTestHandler(t, servicer)
+
+	rec := serve(h, http.MethodPatch, ticketPath, `{"state":"closed"}`)
+	assertStatus(t, rec, http.StatusPreconditionFailed)
+	assertErrorCode(t, rec, ErrorCodePreconditionFailed)
+
+	rec = serve(h, http.MethodPatch, "/v2"+ticketPath, `{"state":"closed"}`)
+	assertStatus(t, rec, http.StatusPreconditionFailed)
+	assertProblem(t, rec, http.StatusPreconditionFailed, ErrorCodePreconditionFailed, "/v2"+ticketPath)
+
+	// invalid requests are rejected by the validator of the spec in both versions
+	rec = serve(h, http.MethodPatch, ticketPath, `{"state":"leased"}`)
+	assertStatus(t, rec, http.StatusBadRequest)
+
+	rec = serve(h, http.MethodPatch, "/v2"+ticketPath, `{"state":"leased"}`)
+	assertStatus(t, rec, http.StatusBadRequest)
+	assertProblem(t, rec, http.StatusBadRequest, ErrorCodeBadRequest, "/v2"+ticketPath)
+}
+
+func TestProblem_UpdateTicket_InvalidState(t *testing.T) {
+	h := newTestHandler(t, newStubServicer())
+
+	// the handler itself rejects unknown states instead of answering 204 without changing the ticket
+	req := httptest.NewRequest(http.MethodPatch, ticketPath, strings.NewReader(`{"state":"leased"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	if err := h.updateTicket(h.e.NewContext(req, rec), testLineageId, "tx1"); err != nil {
+		t.Fatalf("can not update ticket %s", err)
+	}
+
+	assertStatus(t, rec, http.StatusBadRequest)
+	assertErrorCode(t, rec, ErrorCodeBadRequest)
+}
+
+func TestProblem_LeaseTicket_TooManyLeasedTickets(t *testing.T) {
+	servicer := newStubServicer()
+	servicer.leaseTicket = failing(ticket.ErrTooManyLeasedTickets)
+	servicer.lineage = &api.LineageGetResponse{
+		Id:                  testLineageId,
+		LeasedNonceCount:    10,
+		ReleasedNonceCount:  2,
+		MaxLeasedNonceCount: 8,
+	}
+	h := newTestHandler(t, servicer)
+
+	rec := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`)
+	assertStatus(t, rec, http.StatusTooManyRequests)
+	assertErrorCode(t, rec, ErrorCodeTooManyLeasedTickets)
+
+	rec = serve(h, http.MethodPost, "/v2"+leasePath, `{"extIds":["tx1"]}`)
+	assertStatus(t, rec, http.StatusTooManyRequests)
+	problem := assertProblem(t, rec, http.StatusTooManyRequests, ErrorCodeTooManyLeasedTickets, "/v2"+leasePath)
+
+	if problem.LeasedNonceCount == nil || *problem.LeasedNonceCount != 10 ||
+		problem.ReleasedNonceCount == nil || *problem.ReleasedNonceCount != 2 ||
+		problem.MaxLeasedNonceCount == nil || *problem.MaxLeasedNonceCount != 8 {
+
+		t.Errorf("expected problem to carry the counters of the lineage, got %+v", problem)
+	}
+}
+
+func TestProblem_LeaseTicket_Errors(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{ticket.ErrInvalidRequest, http.StatusBadRequest, ErrorCodeBadRequest},
+		{ticket.ErrNoContiguousRange, http.StatusUnprocessableEntity, ErrorCodeNoContiguousRange},
+		{ticket.ErrLineagePaused, http.StatusLocked, ErrorCodeLineagePaused},
+		{ticket.ErrTooManyConcurrentRequests, http.StatusConflict, ErrTooManyConcurrentRequests},
+	}
+
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			servicer := newStubServicer()
+			servicer.leaseTicket = failing(test.err)
+			h := newTestHandler(t, servicer)
+
+			rec := serve(h, http.MethodPost, leasePath, `{"extIds":["tx1"]}`)
+			assertStatus(t, rec, test.status)
+			assertErrorCode(t, rec, test.code)
+
+			rec = serve(h, http.MethodPost, "/v2"+leasePath, `{"extIds":["tx1"]}`)
+			assertStatus(t, rec, test.status)
+			assertProblem(t, rec, test.status, test.code, "/v2"+leasePath)
+		})
+	}
+}
+
+func TestProblem_ErrorHandler(t *testing.T) {
+	h := newTestHandler(t, newStubServicer())
+
+	rec := serve(h, http.MethodPost, leasePath, `{}`)
+	assertStatus(t, rec, http.StatusBadRequest)
+
+	if ct := rec.Header().Get(echo.HeaderContentType); !strings.HasPrefix(ct, echo.MIMEApplicationJSON) {
+		t.Errorf("expected v1 errors of the validator to be JSON, got %s", ct)
+	}
+
+	rec = serve(h, http.MethodPost, "/v2"+leasePath, `{}`)
+	assertStatus(t, rec, http.StatusBadRequest)
+	assertProblem(t, rec, http.StatusBadRequest, ErrorCodeBadRequest, "/v2"+leasePath)
+}
+
+func TestProblemTitle(t *testing.T) {
+	if title := problemTitle(ErrorCodeTooManyLeasedTickets); title != "Too many leased tickets" {
+		t.Errorf("expected title Too many leased tickets, got %s", title)
+	}
+
+	if title := problemTitle(""); title != "" {
+		t.Errorf("expected empty title, got %s", title)
+	}
+}
+
+func assertEmpty(t *testing.T, rec *httptest.ResponseRecorder) {
+	t.Helper()
+
+	if rec.Body.Len() != 0 {
+		t.Errorf("expected empty body, got %s", rec.Body.String())
+	}
+}
+
+func assertProblem(t *testing.T, rec *httptest.ResponseRecorder, status int, code string, instance string) *api.Problem {
+	t.Helper()
+
+	if ct := rec.Header().Get(echo.HeaderContentType); ct != MIMEApplicationProblemJSON {
+		t.Errorf("expected content type %s, got %s", MIMEApplicationProblemJSON, ct)
+	}
+
+	var problem api.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("can not read problem %s from %s", err, rec.Body.String())
+	}
+
+	if problem.Type != ProblemTypePrefix+code || problem.Status != status {
+		t.Errorf("expected problem %s with status %d, got %s with status %d", code, status, problem.Type,
+			problem.Status)
+	}
+
+	if problem.Instance == nil || *problem.Instance != instance {
+		t.Errorf("expected problem instance %s, got %v", instance, problem.Instance)
+	}
+
+	if problem.LineageId == nil || *problem.LineageId != testLineageId {
+		t.Errorf("expected problem of lineage %s, got %v", testLineageId, problem.LineageId)
+	}
+
+	return &problem
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+const (
+	// streamRecheckInterval is how often a multi-lineage stream rereads while events it was notified of are held back
+	// by older transactions which are still running.
+	streamRecheckInterval = time.Second
+	// streamKeepAliveInterval is the longest a stream stays silent, idle connections are kept open by comments.
+	streamKeepAliveInterval = 15 * time.Second
+	// streamBatchSize is the most events a multi-lineage stream reads at once.
+	streamBatchSize = 100
+)
+
+func (h *Handler) StreamLineageEvents(ctx echo.Context, lineageId string, params api.StreamLineageEventsParams) error {
+	rCtx := ctx.Request().Context()
+
+	after, err := h.servicer.GetLineageEventSeq(rCtx, lineageId)
+	if err != nil {
+		switch err {
+		case ticket.ErrNoSuchLineage:
+			return respondError(ctx, http.StatusNotFound, ErrorCodeNotFound, err.Error())
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	if params.LastEventID != nil {
+		after, err = strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil || after < 0 {
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "Last-Event-ID must be the sequence number of an event")
+		}
+	}
+
+	sub := h.servicer.SubscribeLineageEvents(rCtx, lineageId)
+	defer sub.Close()
+
+	stream := newEventStream(ctx)
+	for {
+		resp, err := h.servicer.ListLineageEvents(rCtx, lineageId, &api.ListLineageEventsParams{After: &after})
+		if err != nil {
+			return stream.close(err)
+		}
+
+		for _, event := range resp.Events {
+			if err := stream.send(strconv.FormatInt(event.Seq, 10), event); err != nil {
+				return stream.close(err)
+			}
+			after = event.Seq
+		}
+
+		if len(resp.Events) > 0 {
+			continue
+		}
+
+		if err := stream.wait(rCtx, sub.Wake(), nil); err != nil {
+			return stream.close(err)
+		}
+	}
+}
+
+func (h *Handler) StreamLineagesEvents(ctx echo.Context, params api.StreamLineagesEventsParams) error {
+	rCtx := ctx.Request().Context()
+
+	var cursor ticket.EventCursor
+	var err error
+	if params.LastEventID != nil {
+		cursor, err = decodeStreamCursor(*params.LastEventID)
+		if err != nil {
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, "Last-Event-ID must be the id of an event of the stream")
+		}
+	} else {
+		cursor, err = h.servicer.GetLineagesEventCursor(rCtx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// subscribing before the first read does not miss the notifications of events committed after it
+	sub := h.servicer.SubscribeLineageEvents(rCtx, "")
+	defer sub.Close()
+
+	// the first page is read before the stream is opened, so an invalid label selector fails the request
+	events, next, err := h.servicer.ListLineagesEvents(rCtx, &params, cursor, streamBatchSize)
+	if err != nil {
+		switch err {
+		case ticket.ErrInvalidRequest:
+			return respondError(ctx, http.StatusBadRequest, ErrorCodeBadRequest, err.Error())
+		default:
+			return err
+		}
+	}
+
+	stream := newEventStream(ctx)
+	for {
+		for _, event := range events {
+			if err := stream.send(encodeStreamCursor(event.Cursor), event.Event); err != nil {
+				return stream.close(err)
+			}
+		}
+		cursor = next
+
+		if len(events) < streamBatchSize {
+			// events notified at or past the cursor are not readable until every older transaction ended
+			var recheck <-chan time.Time
+			if cursor.TxId <= sub.TxId() {
+				recheck = time.After(streamRecheckInterval)
+			}
+
+			if err := stream.wait(rCtx, sub.Wake(), recheck); err != nil {
+				return stream.close(err)
+			}
+		}
+
+		events, next, err = h.servicer.ListLineagesEvents(rCtx, &params, cursor, streamBatchSize)
+		if err != nil {
+			return stream.close(err)
+		}
+	}
+}
+
+// encodeStreamCursor encodes the position of a multi-lineage stream as the id of its events.
+func encodeStreamCursor(c ticket.EventCursor) string {
+	return fmt.Sprintf("%d-%d", c.TxId, c.Id)
+}
+
+func decodeStreamCursor(s string) (ticket.EventCursor, error) {
+	txId, id, ok := strings.Cut(s, "-")
+	if !ok {
+		return ticket.EventCursor{}, ticket.ErrInvalidRequest
+	}
+
+	var c ticket.EventCursor
+	var err error
+	if c.TxId, err = strconv.ParseInt(txId, 10, 64); err != nil || c.TxId < 0 {
+		return ticket.EventCursor{}, ticket.ErrInvalidRequest
+	}
+	if c.Id, err = strconv.ParseInt(id, 10, 64); err != nil || c.Id < 0 {
+		return ticket.EventCursor{}, ticket.ErrInvalidRequest
+	}
+
+	return c, nil
+}
+
+// eventStream writes lineage events as server-sent events.
+type eventStream struct {
+	ctx       echo.Context
+	lastWrite time.Time
+}
+
+func newEventStream(ctx echo.Context) *eventStream {
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set(echo.HeaderCacheControl, "no-cache")
+	resp.Header().Set(echo.HeaderConnection, "keep-alive")
+	resp.WriteHeader(http.StatusOK)
+	resp.Flush()
+
+	return &eventStream{ctx: ctx, lastWrite: time.Now()}
+}
+
+func (s *eventStream) send(id string, event api.LineageEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if id != "" {
+		b.WriteString(fmt.Sprintf("id: %s\n", id))
+	}
+	b.WriteString(fmt.Sprintf("event: %s\ndata: %s\n\n", event.Type, data))
+
+	return s.write(b.String())
+}
+
+// wait returns once woken or once recheck fires, keeping the connection alive meanwhile, or with an error once the
+// client went away.
+func (s *eventStream) wait(ctx context.Context, wake <-chan struct{}, recheck <-chan time.Time) error {
+	for {
+		keepAlive := time.NewTimer(streamKeepAliveInterval - time.Since(s.lastWrite))
+
+		select {
+		case <-ctx.Done():
+			keepAlive.Stop()
+			return ctx.Err()
+		case <-wake:
+			keepAlive.Stop()
+			return nil
+		case <-recheck:
+			keepAlive.Stop()
+			return nil
+		case <-keepAlive.C:
+			if err := s.write(": keep-alive\n\n"); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *eventStream) write(msg string) error {
+	resp := s.ctx.Response()
+	if _, err := resp.Write([]byte(msg)); err != nil {
+		return err
+	}
+	resp.Flush()
+	s.lastWrite = time.Now()
+
+	return nil
+}
+
+// close ends the stream. Clients going away is the normal end of a stream, any other error is logged since the
+// response has been committed already.
+func (s *eventStream) close(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	log.Ctx(s.ctx.Request().Context()).Error().Err(err).Msg("event stream failed")
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"time"
+
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	pb "github.com/welthee/dinonce/v2/internal/rpc/generated"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toLineage(l *api.LineageGetResponse) *pb.Lineage {
+	lineage := &pb.Lineage{
+		Id:                  l.Id,
+		ExtId:               l.ExtId,
+		Namespace:           l.Namespace,
+		NextNonce:           int64(l.NextNonce),
+		LeasedNonceCount:    int32(l.LeasedNonceCount),
+		ReleasedNonceCount:  int32(l.ReleasedNonceCount),
+		ClosedNonceCount:    int32(l.ClosedNonceCount),
+		MaxLeasedNonceCount: int32(l.MaxLeasedNonceCount),
+		MaxNonceValue:       int64(l.MaxNonceValue),
+		Version:             int64(l.Version),
+		CreatedAt:           toTimestamp(l.CreatedAt),
+		Labels:              l.Labels,
+		ChainId:             l.ChainId,
+		Address:             l.Address,
+	}
+
+	if l.StartLeasingFrom != nil {
+		startLeasingFrom := int64(*l.StartLeasingFrom)
+		lineage.StartLeasingFrom = &startLeasingFrom
+	}
+
+	return lineage
+}
+
+func toLineages(ls []api.LineageGetResponse) []*pb.Lineage {
+	lineages := make([]*pb.Lineage, 0, len(ls))
+	for i := range ls {
+		lineages = append(lineages, toLineage(&ls[i]))
+	}
+
+	return lineages
+}
+
+func toLineageEvent(e *api.LineageEvent) *pb.LineageEvent {
+	return &pb.LineageEvent{
+		Seq:       e.Seq,
+		LineageId: e.LineageId,
+		Type:      string(e.Type),
+		ExtId:     e.ExtId,
+		Nonce:     e.Nonce,
+		Actor:     e.Actor,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+func toTickets(leases *[]api.TicketLease) []*pb.Ticket {
+	if leases == nil {
+		return nil
+	}
+
+	tickets := make([]*pb.Ticket, 0, len(*leases))
+	for _, l := range *leases {
+		tickets = append(tickets, &pb.Ticket{
+			LineageId: l.LineageId,
+			ExtId:     l.ExtId,
+			Nonce:     int64(l.Nonce),
+			State:     string(l.State),
+			LeasedAt:  toTimestamp(l.LeasedAt),
+		})
+	}
+
+	return tickets
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+// optional returns a pointer to v, nil for the zero value which proto3 can not tell apart from an absent field.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+
+	return &v
+}
+
+func valueOrZero[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+
+	return *v
+}
@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/welthee/dinonce/v2/internal/api"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo details attached to the errors of the gRPC API.
+const ErrorDomain = "dinonce"
+
+// toStatus maps the errors of the ticket.Servicer to gRPC statuses. The status codes follow the HTTP status codes
+// of the REST API and the ErrorInfo reason is the code of the corresponding REST error.
+func toStatus(ctx context.Context, err error) error {
+	var code codes.Code
+	var reason string
+
+	switch err {
+	case ticket.ErrNoSuchLineage, ticket.ErrNoSuchTicket, ticket.ErrNoSuchNamespace:
+		code, reason = codes.NotFound, api.ErrorCodeNotFound
+	case ticket.ErrInvalidRequest:
+		code, reason = codes.InvalidArgument, api.ErrorCodeBadRequest
+	case ticket.ErrTooManyLeasedTickets:
+		code, reason = codes.ResourceExhausted, api.ErrorCodeTooManyLeasedTickets
+	case ticket.ErrTooManyConcurrentRequests:
+		code, reason = codes.Aborted, api.ErrTooManyConcurrentRequests
+	case ticket.ErrIdempotencyKeyInProgress:
+		code, reason = codes.Aborted, api.ErrorCodeIdempotencyKeyInProgress
+	case ticket.ErrIdempotencyKeyReused:
+		code, reason = codes.FailedPrecondition, api.ErrorCodeIdempotencyKeyReused
+	case ticket.ErrNamespaceLimitExceeded:
+		code, reason = codes.PermissionDenied, api.ErrorCodeNamespaceLimitExceeded
+	case ticket.ErrLineageConflict:
+		code, reason = codes.AlreadyExists, api.ErrorCodeLineageConflict
+	case ticket.ErrNoContiguousRange:
+		code, reason = codes.FailedPrecondition, api.ErrorCodeNoContiguousRange
+	case ticket.ErrLineagePaused:
+		code, reason = codes.FailedPrecondition, api.ErrorCodeLineagePaused
+	case ticket.ErrLineageVersionMismatch:
+		code, reason = codes.FailedPrecondition, api.ErrorCodePreconditionFailed
+	case api.ErrUnauthorized:
+		code, reason = codes.Unauthenticated, api.ErrorCodeUnauthorized
+	case context.Canceled, context.DeadlineExceeded:
+		return status.FromContextError(err).Err()
+	default:
+		if s, ok := status.FromError(err); ok {
+			return s.Err()
+		}
+
+		log.Ctx(ctx).Error().Err(err).Msg("unexpected error")
+
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	s, detailErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+	})
+	if detailErr != nil {
+		return status.Error(code, err.Error())
+	}
+
+	return s.Err()
+}
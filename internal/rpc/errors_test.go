@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/welthee/dinonce/v2/internal/api"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{ticket.ErrNoSuchLineage, codes.NotFound, api.ErrorCodeNotFound},
+		{ticket.ErrNoSuchTicket, codes.NotFound, api.ErrorCodeNotFound},
+		{ticket.ErrNoSuchNamespace, codes.NotFound, api.ErrorCodeNotFound},
+		{ticket.ErrInvalidRequest, codes.InvalidArgument, api.ErrorCodeBadRequest},
+		{ticket.ErrTooManyLeasedTickets, codes.ResourceExhausted, api.ErrorCodeTooManyLeasedTickets},
+		{ticket.ErrTooManyConcurrentRequests, codes.Aborted, api.ErrTooManyConcurrentRequests},
+		{ticket.ErrIdempotencyKeyInProgress, codes.Aborted, api.ErrorCodeIdempotencyKeyInProgress},
+		{ticket.ErrIdempotencyKeyReused, codes.FailedPrecondition, api.ErrorCodeIdempotencyKeyReused},
+		{ticket.ErrNamespaceLimitExceeded, codes.PermissionDenied, api.ErrorCodeNamespaceLimitExceeded},
+		{ticket.ErrLineageConflict, codes.AlreadyExists, api.ErrorCodeLineageConflict},
+		{ticket.ErrNoContiguousRange, codes.FailedPrecondition, api.ErrorCodeNoContiguousRange},
+		{ticket.ErrLineagePaused, codes.FailedPrecondition, api.ErrorCodeLineagePaused},
+		{ticket.ErrLineageVersionMismatch, codes.FailedPrecondition, api.ErrorCodePreconditionFailed},
+		{api.ErrUnauthorized, codes.Unauthenticated, api.ErrorCodeUnauthorized},
+	}
+
+	for _, tt := range tests {
+		s := status.Convert(toStatus(context.Background(), tt.err))
+		if s.Code() != tt.code {
+			t.Errorf("%q: expected code %s, got %s", tt.err, tt.code, s.Code())
+		}
+
+		if s.Message() != tt.err.Error() {
+			t.Errorf("%q: expected message %q, got %q", tt.err, tt.err.Error(), s.Message())
+		}
+
+		details := s.Details()
+		if len(details) != 1 {
+			t.Errorf("%q: expected one detail, got %d", tt.err, len(details))
+			continue
+		}
+
+		info, ok := details[0].(*errdetails.ErrorInfo)
+		if !ok || info.Reason != tt.reason || info.Domain != ErrorDomain {
+			t.Errorf("%q: expected ErrorInfo %s/%s, got %v", tt.err, ErrorDomain, tt.reason, details[0])
+		}
+	}
+}
+
+func TestToStatus_Passthrough(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{context.Canceled, codes.Canceled},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{status.Error(codes.Unavailable, "unavailable"), codes.Unavailable},
+		{errors.New("connection refused"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		s := status.Convert(toStatus(context.Background(), tt.err))
+		if s.Code() != tt.code {
+			t.Errorf("%q: expected code %s, got %s", tt.err, tt.code, s.Code())
+		}
+	}
+
+	if s := status.Convert(toStatus(context.Background(), errors.New("connection refused"))); s.Message() != "internal error" {
+		t.Errorf("expected unexpected errors to be hidden, got %q", s.Message())
+	}
+}
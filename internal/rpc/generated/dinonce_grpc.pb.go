@@ -0,0 +1,771 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: dinonce/v1/dinonce.proto
+
+package dinoncev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DinonceService_CreateLineage_FullMethodName      = "/dinonce.v1.DinonceService/CreateLineage"
+	DinonceService_GetLineage_FullMethodName         = "/dinonce.v1.DinonceService/GetLineage"
+	DinonceService_UpdateLineage_FullMethodName      = "/dinonce.v1.DinonceService/UpdateLineage"
+	DinonceService_ListLineages_FullMethodName       = "/dinonce.v1.DinonceService/ListLineages"
+	DinonceService_GetLineageStats_FullMethodName    = "/dinonce.v1.DinonceService/GetLineageStats"
+	DinonceService_CloneLineage_FullMethodName       = "/dinonce.v1.DinonceService/CloneLineage"
+	DinonceService_ListLineageEvents_FullMethodName  = "/dinonce.v1.DinonceService/ListLineageEvents"
+	DinonceService_WatchLineageEvents_FullMethodName = "/dinonce.v1.DinonceService/WatchLineageEvents"
+	DinonceService_LeaseTickets_FullMethodName       = "/dinonce.v1.DinonceService/LeaseTickets"
+	DinonceService_GetTicket_FullMethodName          = "/dinonce.v1.DinonceService/GetTicket"
+	DinonceService_GetTickets_FullMethodName         = "/dinonce.v1.DinonceService/GetTickets"
+	DinonceService_ListTickets_FullMethodName        = "/dinonce.v1.DinonceService/ListTickets"
+	DinonceService_ReleaseTicket_FullMethodName      = "/dinonce.v1.DinonceService/ReleaseTicket"
+	DinonceService_CloseTicket_FullMethodName        = "/dinonce.v1.DinonceService/CloseTicket"
+	DinonceService_TransferTicket_FullMethodName     = "/dinonce.v1.DinonceService/TransferTicket"
+	DinonceService_UpdateTickets_FullMethodName      = "/dinonce.v1.DinonceService/UpdateTickets"
+	DinonceService_GetTicketHistory_FullMethodName   = "/dinonce.v1.DinonceService/GetTicketHistory"
+	DinonceService_GetNonce_FullMethodName           = "/dinonce.v1.DinonceService/GetNonce"
+)
+
+// DinonceServiceClient is the client API for DinonceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DinonceServiceClient interface {
+	CreateLineage(ctx context.Context, in *CreateLineageRequest, opts ...grpc.CallOption) (*CreateLineageResponse, error)
+	GetLineage(ctx context.Context, in *GetLineageRequest, opts ...grpc.CallOption) (*Lineage, error)
+	UpdateLineage(ctx context.Context, in *UpdateLineageRequest, opts ...grpc.CallOption) (*Lineage, error)
+	ListLineages(ctx context.Context, in *ListLineagesRequest, opts ...grpc.CallOption) (*ListLineagesResponse, error)
+	GetLineageStats(ctx context.Context, in *GetLineageStatsRequest, opts ...grpc.CallOption) (*LineageStats, error)
+	CloneLineage(ctx context.Context, in *CloneLineageRequest, opts ...grpc.CallOption) (*Lineage, error)
+	ListLineageEvents(ctx context.Context, in *ListLineageEventsRequest, opts ...grpc.CallOption) (*ListLineageEventsResponse, error)
+	// WatchLineageEvents streams the events of a lineage after the given sequence number as they are recorded,
+	// until the client cancels the call.
+	WatchLineageEvents(ctx context.Context, in *WatchLineageEventsRequest, opts ...grpc.CallOption) (DinonceService_WatchLineageEventsClient, error)
+	LeaseTickets(ctx context.Context, in *LeaseTicketsRequest, opts ...grpc.CallOption) (*LeaseTicketsResponse, error)
+	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	GetTickets(ctx context.Context, in *GetTicketsRequest, opts ...grpc.CallOption) (*GetTicketsResponse, error)
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error)
+	ReleaseTicket(ctx context.Context, in *ReleaseTicketRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CloseTicket(ctx context.Context, in *CloseTicketRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransferTicket(ctx context.Context, in *TransferTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	UpdateTickets(ctx context.Context, in *UpdateTicketsRequest, opts ...grpc.CallOption) (*UpdateTicketsResponse, error)
+	GetTicketHistory(ctx context.Context, in *GetTicketHistoryRequest, opts ...grpc.CallOption) (*GetTicketHistoryResponse, error)
+	GetNonce(ctx context.Context, in *GetNonceRequest, opts ...grpc.CallOption) (*GetNonceResponse, error)
+}
+
+type dinonceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDinonceServiceClient(cc grpc.ClientConnInterface) DinonceServiceClient {
+	return &dinonceServiceClient{cc}
+}
+
+func (c *dinonceServiceClient) CreateLineage(ctx context.Context, in *CreateLineageRequest, opts ...grpc.CallOption) (*CreateLineageResponse, error) {
+	out := new(CreateLineageResponse)
+	err := c.cc.Invoke(ctx, DinonceService_CreateLineage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) GetLineage(ctx context.Context, in *GetLineageRequest, opts ...grpc.CallOption) (*Lineage, error) {
+	out := new(Lineage)
+	err := c.cc.Invoke(ctx, DinonceService_GetLineage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) UpdateLineage(ctx context.Context, in *UpdateLineageRequest, opts ...grpc.CallOption) (*Lineage, error) {
+	out := new(Lineage)
+	err := c.cc.Invoke(ctx, DinonceService_UpdateLineage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) ListLineages(ctx context.Context, in *ListLineagesRequest, opts ...grpc.CallOption) (*ListLineagesResponse, error) {
+	out := new(ListLineagesResponse)
+	err := c.cc.Invoke(ctx, DinonceService_ListLineages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) GetLineageStats(ctx context.Context, in *GetLineageStatsRequest, opts ...grpc.CallOption) (*LineageStats, error) {
+	out := new(LineageStats)
+	err := c.cc.Invoke(ctx, DinonceService_GetLineageStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) CloneLineage(ctx context.Context, in *CloneLineageRequest, opts ...grpc.CallOption) (*Lineage, error) {
+	out := new(Lineage)
+	err := c.cc.Invoke(ctx, DinonceService_CloneLineage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) ListLineageEvents(ctx context.Context, in *ListLineageEventsRequest, opts ...grpc.CallOption) (*ListLineageEventsResponse, error) {
+	out := new(ListLineageEventsResponse)
+	err := c.cc.Invoke(ctx, DinonceService_ListLineageEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) WatchLineageEvents(ctx context.Context, in *WatchLineageEventsRequest, opts ...grpc.CallOption) (DinonceService_WatchLineageEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DinonceService_ServiceDesc.Streams[0], DinonceService_WatchLineageEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dinonceServiceWatchLineageEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DinonceService_WatchLineageEventsClient interface {
+	Recv() (*LineageEvent, error)
+	grpc.ClientStream
+}
+
+type dinonceServiceWatchLineageEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dinonceServiceWatchLineageEventsClient) Recv() (*LineageEvent, error) {
+	m := new(LineageEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dinonceServiceClient) LeaseTickets(ctx context.Context, in *LeaseTicketsRequest, opts ...grpc.CallOption) (*LeaseTicketsResponse, error) {
+	out := new(LeaseTicketsResponse)
+	err := c.cc.Invoke(ctx, DinonceService_LeaseTickets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, DinonceService_GetTicket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) GetTickets(ctx context.Context, in *GetTicketsRequest, opts ...grpc.CallOption) (*GetTicketsResponse, error) {
+	out := new(GetTicketsResponse)
+	err := c.cc.Invoke(ctx, DinonceService_GetTickets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error) {
+	out := new(ListTicketsResponse)
+	err := c.cc.Invoke(ctx, DinonceService_ListTickets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) ReleaseTicket(ctx context.Context, in *ReleaseTicketRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DinonceService_ReleaseTicket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) CloseTicket(ctx context.Context, in *CloseTicketRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DinonceService_CloseTicket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) TransferTicket(ctx context.Context, in *TransferTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, DinonceService_TransferTicket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) UpdateTickets(ctx context.Context, in *UpdateTicketsRequest, opts ...grpc.CallOption) (*UpdateTicketsResponse, error) {
+	out := new(UpdateTicketsResponse)
+	err := c.cc.Invoke(ctx, DinonceService_UpdateTickets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) GetTicketHistory(ctx context.Context, in *GetTicketHistoryRequest, opts ...grpc.CallOption) (*GetTicketHistoryResponse, error) {
+	out := new(GetTicketHistoryResponse)
+	err := c.cc.Invoke(ctx, DinonceService_GetTicketHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinonceServiceClient) GetNonce(ctx context.Context, in *GetNonceRequest, opts ...grpc.CallOption) (*GetNonceResponse, error) {
+	out := new(GetNonceResponse)
+	err := c.cc.Invoke(ctx, DinonceService_GetNonce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DinonceServiceServer is the server API for DinonceService service.
+// All implementations must embed UnimplementedDinonceServiceServer
+// for forward compatibility
+type DinonceServiceServer interface {
+	CreateLineage(context.Context, *CreateLineageRequest) (*CreateLineageResponse, error)
+	GetLineage(context.Context, *GetLineageRequest) (*Lineage, error)
+	UpdateLineage(context.Context, *UpdateLineageRequest) (*Lineage, error)
+	ListLineages(context.Context, *ListLineagesRequest) (*ListLineagesResponse, error)
+	GetLineageStats(context.Context, *GetLineageStatsRequest) (*LineageStats, error)
+	CloneLineage(context.Context, *CloneLineageRequest) (*Lineage, error)
+	ListLineageEvents(context.Context, *ListLineageEventsRequest) (*ListLineageEventsResponse, error)
+	// WatchLineageEvents streams the events of a lineage after the given sequence number as they are recorded,
+	// until the client cancels the call.
+	WatchLineageEvents(*WatchLineageEventsRequest, DinonceService_WatchLineageEventsServer) error
+	LeaseTickets(context.Context, *LeaseTicketsRequest) (*LeaseTicketsResponse, error)
+	GetTicket(context.Context, *GetTicketRequest) (*Ticket, error)
+	GetTickets(context.Context, *GetTicketsRequest) (*GetTicketsResponse, error)
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error)
+	ReleaseTicket(context.Context, *ReleaseTicketRequest) (*emptypb.Empty, error)
+	CloseTicket(context.Context, *CloseTicketRequest) (*emptypb.Empty, error)
+	TransferTicket(context.Context, *TransferTicketRequest) (*Ticket, error)
+	UpdateTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error)
+	GetTicketHistory(context.Context, *GetTicketHistoryRequest) (*GetTicketHistoryResponse, error)
+	GetNonce(context.Context, *GetNonceRequest) (*GetNonceResponse, error)
+	mustEmbedUnimplementedDinonceServiceServer()
+}
+
+// UnimplementedDinonceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDinonceServiceServer struct {
+}
+
+func (UnimplementedDinonceServiceServer) CreateLineage(context.Context, *CreateLineageRequest) (*CreateLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLineage not implemented")
+}
+func (UnimplementedDinonceServiceServer) GetLineage(context.Context, *GetLineageRequest) (*Lineage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineage not implemented")
+}
+func (UnimplementedDinonceServiceServer) UpdateLineage(context.Context, *UpdateLineageRequest) (*Lineage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLineage not implemented")
+}
+func (UnimplementedDinonceServiceServer) ListLineages(context.Context, *ListLineagesRequest) (*ListLineagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLineages not implemented")
+}
+func (UnimplementedDinonceServiceServer) GetLineageStats(context.Context, *GetLineageStatsRequest) (*LineageStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineageStats not implemented")
+}
+func (UnimplementedDinonceServiceServer) CloneLineage(context.Context, *CloneLineageRequest) (*Lineage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneLineage not implemented")
+}
+func (UnimplementedDinonceServiceServer) ListLineageEvents(context.Context, *ListLineageEventsRequest) (*ListLineageEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLineageEvents not implemented")
+}
+func (UnimplementedDinonceServiceServer) WatchLineageEvents(*WatchLineageEventsRequest, DinonceService_WatchLineageEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLineageEvents not implemented")
+}
+func (UnimplementedDinonceServiceServer) LeaseTickets(context.Context, *LeaseTicketsRequest) (*LeaseTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseTickets not implemented")
+}
+func (UnimplementedDinonceServiceServer) GetTicket(context.Context, *GetTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedDinonceServiceServer) GetTickets(context.Context, *GetTicketsRequest) (*GetTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickets not implemented")
+}
+func (UnimplementedDinonceServiceServer) ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
+func (UnimplementedDinonceServiceServer) ReleaseTicket(context.Context, *ReleaseTicketRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseTicket not implemented")
+}
+func (UnimplementedDinonceServiceServer) CloseTicket(context.Context, *CloseTicketRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTicket not implemented")
+}
+func (UnimplementedDinonceServiceServer) TransferTicket(context.Context, *TransferTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTicket not implemented")
+}
+func (UnimplementedDinonceServiceServer) UpdateTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTickets not implemented")
+}
+func (UnimplementedDinonceServiceServer) GetTicketHistory(context.Context, *GetTicketHistoryRequest) (*GetTicketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketHistory not implemented")
+}
+func (UnimplementedDinonceServiceServer) GetNonce(context.Context, *GetNonceRequest) (*GetNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNonce not implemented")
+}
+func (UnimplementedDinonceServiceServer) mustEmbedUnimplementedDinonceServiceServer() {}
+
+// UnsafeDinonceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DinonceServiceServer will
+// result in compilation errors.
+type UnsafeDinonceServiceServer interface {
+	mustEmbedUnimplementedDinonceServiceServer()
+}
+
+func RegisterDinonceServiceServer(s grpc.ServiceRegistrar, srv DinonceServiceServer) {
+	s.RegisterService(&DinonceService_ServiceDesc, srv)
+}
+
+func _DinonceService_CreateLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).CreateLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_CreateLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).CreateLineage(ctx, req.(*CreateLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_GetLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).GetLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_GetLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).GetLineage(ctx, req.(*GetLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_UpdateLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).UpdateLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_UpdateLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).UpdateLineage(ctx, req.(*UpdateLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_ListLineages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLineagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).ListLineages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_ListLineages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).ListLineages(ctx, req.(*ListLineagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_GetLineageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLineageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).GetLineageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_GetLineageStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).GetLineageStats(ctx, req.(*GetLineageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_CloneLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).CloneLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_CloneLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).CloneLineage(ctx, req.(*CloneLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_ListLineageEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLineageEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).ListLineageEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_ListLineageEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).ListLineageEvents(ctx, req.(*ListLineageEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_WatchLineageEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLineageEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DinonceServiceServer).WatchLineageEvents(m, &dinonceServiceWatchLineageEventsServer{stream})
+}
+
+type DinonceService_WatchLineageEventsServer interface {
+	Send(*LineageEvent) error
+	grpc.ServerStream
+}
+
+type dinonceServiceWatchLineageEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dinonceServiceWatchLineageEventsServer) Send(m *LineageEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DinonceService_LeaseTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).LeaseTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_LeaseTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).LeaseTickets(ctx, req.(*LeaseTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).GetTicket(ctx, req.(*GetTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_GetTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).GetTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_GetTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).GetTickets(ctx, req.(*GetTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).ListTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_ListTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).ListTickets(ctx, req.(*ListTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_ReleaseTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).ReleaseTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_ReleaseTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).ReleaseTicket(ctx, req.(*ReleaseTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_CloseTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).CloseTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_CloseTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).CloseTicket(ctx, req.(*CloseTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_TransferTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).TransferTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_TransferTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).TransferTicket(ctx, req.(*TransferTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_UpdateTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).UpdateTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_UpdateTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).UpdateTickets(ctx, req.(*UpdateTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_GetTicketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).GetTicketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_GetTicketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).GetTicketHistory(ctx, req.(*GetTicketHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinonceService_GetNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinonceServiceServer).GetNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinonceService_GetNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinonceServiceServer).GetNonce(ctx, req.(*GetNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DinonceService_ServiceDesc is the grpc.ServiceDesc for DinonceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DinonceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dinonce.v1.DinonceService",
+	HandlerType: (*DinonceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLineage",
+			Handler:    _DinonceService_CreateLineage_Handler,
+		},
+		{
+			MethodName: "GetLineage",
+			Handler:    _DinonceService_GetLineage_Handler,
+		},
+		{
+			MethodName: "UpdateLineage",
+			Handler:    _DinonceService_UpdateLineage_Handler,
+		},
+		{
+			MethodName: "ListLineages",
+			Handler:    _DinonceService_ListLineages_Handler,
+		},
+		{
+			MethodName: "GetLineageStats",
+			Handler:    _DinonceService_GetLineageStats_Handler,
+		},
+		{
+			MethodName: "CloneLineage",
+			Handler:    _DinonceService_CloneLineage_Handler,
+		},
+		{
+			MethodName: "ListLineageEvents",
+			Handler:    _DinonceService_ListLineageEvents_Handler,
+		},
+		{
+			MethodName: "LeaseTickets",
+			Handler:    _DinonceService_LeaseTickets_Handler,
+		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _DinonceService_GetTicket_Handler,
+		},
+		{
+			MethodName: "GetTickets",
+			Handler:    _DinonceService_GetTickets_Handler,
+		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _DinonceService_ListTickets_Handler,
+		},
+		{
+			MethodName: "ReleaseTicket",
+			Handler:    _DinonceService_ReleaseTicket_Handler,
+		},
+		{
+			MethodName: "CloseTicket",
+			Handler:    _DinonceService_CloseTicket_Handler,
+		},
+		{
+			MethodName: "TransferTicket",
+			Handler:    _DinonceService_TransferTicket_Handler,
+		},
+		{
+			MethodName: "UpdateTickets",
+			Handler:    _DinonceService_UpdateTickets_Handler,
+		},
+		{
+			MethodName: "GetTicketHistory",
+			Handler:    _DinonceService_GetTicketHistory_Handler,
+		},
+		{
+			MethodName: "GetNonce",
+			Handler:    _DinonceService_GetNonce_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLineageEvents",
+			Handler:       _DinonceService_WatchLineageEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dinonce/v1/dinonce.proto",
+}
@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/welthee/dinonce/v2/internal/api"
+	pb "github.com/welthee/dinonce/v2/internal/rpc/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultPort is the port the gRPC API listens on unless configured otherwise.
+const DefaultPort = 5011
+
+// MetadataNamespace scopes a call to a namespace, it is the gRPC counterpart of the /namespaces/{namespace} path
+// prefix of the REST API.
+const MetadataNamespace = "dinonce-namespace"
+
+// MetadataActor names the caller on whose behalf a call changes tickets, see api.HeaderActor. Like the header it is
+// asserted by the caller and recorded next to the principal of the API key of the call.
+const MetadataActor = "x-actor"
+
+const metadataAuthorization = "authorization"
+
+// Server serves the ticket.Servicer over gRPC, together with the standard gRPC health service.
+type Server struct {
+	pb.UnimplementedDinonceServiceServer
+
+	servicer    ticket.Servicer
+	credentials api.NamespaceCredentials
+	server      *grpc.Server
+	health      *health.Server
+	port        int
+}
+
+func NewServer(servicer ticket.Servicer, credentials api.NamespaceCredentials, port int) *Server {
+	s := &Server{
+		servicer:    servicer,
+		credentials: credentials,
+		health:      health.NewServer(),
+		port:        port,
+	}
+
+	s.server = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.unaryInterceptor),
+		grpc.ChainStreamInterceptor(s.streamInterceptor),
+	)
+
+	pb.RegisterDinonceServiceServer(s.server, s)
+	healthpb.RegisterHealthServer(s.server, s.health)
+
+	return s
+}
+
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return err
+	}
+
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	s.health.SetServingStatus(pb.DinonceService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	return s.server.Serve(lis)
+}
+
+// Stop marks the server as not serving and waits for the running calls to finish. Calls still running when ctx is
+// done are cancelled.
+func (s *Server) Stop(ctx context.Context) error {
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
+
+func (s *Server) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	start := time.Now()
+
+	ctx, err := s.scope(ctx, info.FullMethod)
+	var resp interface{}
+	if err == nil {
+		resp, err = handler(ctx, req)
+	}
+
+	logCall(ctx, info.FullMethod, start, err)
+
+	return resp, err
+}
+
+func (s *Server) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	start := time.Now()
+
+	ctx, err := s.scope(ss.Context(), info.FullMethod)
+	if err == nil {
+		err = handler(srv, &scopedStream{ServerStream: ss, ctx: ctx})
+	}
+
+	logCall(ctx, info.FullMethod, start, err)
+
+	return err
+}
+
+// scope is the gRPC counterpart of the namespace and actor middlewares of the REST API. It scopes calls of the
+// DinonceService to the namespace of their metadata after checking their API key, and attributes them to their actor.
+func (s *Server) scope(ctx context.Context, method string) (context.Context, error) {
+	if !strings.HasPrefix(method, "/"+pb.DinonceService_ServiceDesc.ServiceName+"/") {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	namespace := ticket.DefaultNamespace
+	if v := md.Get(MetadataNamespace); len(v) > 0 && v[0] != "" {
+		namespace = v[0]
+	}
+
+	var key string
+	if v := md.Get(metadataAuthorization); len(v) > 0 {
+		if token, ok := strings.CutPrefix(v[0], "Bearer "); ok {
+			key = token
+		}
+	}
+
+	if err := s.credentials.Authorize(namespace, key); err != nil {
+		return ctx, toStatus(ctx, err)
+	}
+
+	ctx = ticket.WithNamespace(ctx, namespace)
+
+	if principal := s.credentials.Principal(namespace, key); principal != "" {
+		ctx = ticket.WithPrincipal(ctx, principal)
+	}
+
+	if v := md.Get(MetadataActor); len(v) > 0 && v[0] != "" {
+		if err := ticket.ValidateActor(v[0]); err != nil {
+			return ctx, toStatus(ctx, err)
+		}
+
+		ctx = ticket.WithActor(ctx, v[0])
+	}
+
+	return ctx, nil
+}
+
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	log.Ctx(ctx).Info().
+		Str("component", "grpc").
+		Str("method", method).
+		Str("code", status.Code(err).String()).
+		Dur("latency", time.Since(start)).
+		Msg("")
+}
+
+type scopedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *scopedStream) Context() context.Context {
+	return s.ctx
+}
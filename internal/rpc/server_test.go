@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/welthee/dinonce/v2/internal/api"
+	pb "github.com/welthee/dinonce/v2/internal/rpc/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testKey = "s3cr3t"
+
+var testMethod = "/" + pb.DinonceService_ServiceDesc.ServiceName + "/GetLineage"
+
+func newTestServer(servicer ticket.Servicer) *Server {
+	digest := sha256.Sum256([]byte(testKey))
+
+	return NewServer(servicer, api.NamespaceCredentials{
+		"tenant": {hex.EncodeToString(digest[:])},
+	}, 0)
+}
+
+func incomingContext(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func TestServer_Scope(t *testing.T) {
+	s := newTestServer(nil)
+
+	ctx, err := s.scope(incomingContext(MetadataNamespace, "tenant", "authorization", "Bearer "+testKey,
+		MetadataActor, "alice"), testMethod)
+	if err != nil {
+		t.Fatalf("expected call to be scoped, got %s", err)
+	}
+
+	if ns := ticket.NamespaceFromContext(ctx); ns != "tenant" {
+		t.Errorf("expected namespace tenant, got %q", ns)
+	}
+
+	if principal := ticket.PrincipalFromContext(ctx); principal != api.KeyPrincipal(testKey) {
+		t.Errorf("expected principal %q, got %q", api.KeyPrincipal(testKey), principal)
+	}
+
+	if actor := ticket.ActorFromContext(ctx); actor != "alice" {
+		t.Errorf("expected actor alice, got %q", actor)
+	}
+}
+
+func TestServer_Scope_DefaultNamespace(t *testing.T) {
+	s := newTestServer(nil)
+
+	ctx, err := s.scope(incomingContext(), testMethod)
+	if err != nil {
+		t.Fatalf("expected call to be scoped, got %s", err)
+	}
+
+	if ns := ticket.NamespaceFromContext(ctx); ns != ticket.DefaultNamespace {
+		t.Errorf("expected default namespace, got %q", ns)
+	}
+
+	if principal := ticket.PrincipalFromContext(ctx); principal != "" {
+		t.Errorf("expected no principal, got %q", principal)
+	}
+}
+
+func TestServer_Scope_Rejected(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"unknown namespace", incomingContext(MetadataNamespace, "unknown"), codes.NotFound},
+		{"bad key", incomingContext(MetadataNamespace, "tenant", "authorization", "Bearer wrong"),
+			codes.Unauthenticated},
+		{"missing bearer token", incomingContext(MetadataNamespace, "tenant"), codes.Unauthenticated},
+		{"key without bearer scheme", incomingContext(MetadataNamespace, "tenant", "authorization", testKey),
+			codes.Unauthenticated},
+		{"invalid actor", incomingContext(MetadataActor, "<script>"), codes.InvalidArgument},
+	}
+
+	s := newTestServer(nil)
+	for _, tt := range tests {
+		if _, err := s.scope(tt.ctx, testMethod); status.Code(err) != tt.code {
+			t.Errorf("%s: expected code %s, got %v", tt.name, tt.code, err)
+		}
+	}
+}
+
+func TestServer_Scope_OtherServices(t *testing.T) {
+	s := newTestServer(nil)
+
+	_, err := s.scope(incomingContext(MetadataNamespace, "unknown"), "/grpc.health.v1.Health/Check")
+	if err != nil {
+		t.Errorf("expected health checks not to be scoped, got %s", err)
+	}
+}
@@ -0,0 +1,443 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	pb "github.com/welthee/dinonce/v2/internal/rpc/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The limits the REST API enforces through its OpenAPI schema.
+const (
+	maxLeasedNonceCountLimit = 32767
+	maxLabelCount            = 64
+	maxLabelValueLength      = 255
+	maxPageLimit             = 1000
+	maxBulkUpdateCount       = 1000
+)
+
+func (s *Server) CreateLineage(ctx context.Context, req *pb.CreateLineageRequest) (*pb.CreateLineageResponse, error) {
+	if req.MaxLeasedNonceCount < 1 || req.MaxLeasedNonceCount > maxLeasedNonceCountLimit ||
+		req.StartLeasingFrom < 0 || (req.ChainId != nil && *req.ChainId < 1) || !validLabels(req.Labels) {
+
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	startLeasingFrom := int(req.StartLeasingFrom)
+
+	var labels *api.Labels
+	if len(req.Labels) > 0 {
+		l := api.Labels(req.Labels)
+		labels = &l
+	}
+
+	resp, err := s.servicer.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               req.ExtId,
+		MaxLeasedNonceCount: int(req.MaxLeasedNonceCount),
+		StartLeasingFrom:    &startLeasingFrom,
+		ChainId:             req.ChainId,
+		Address:             req.Address,
+		Labels:              labels,
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.CreateLineageResponse{Id: resp.Id, ExtId: resp.ExtId}, nil
+}
+
+func (s *Server) GetLineage(ctx context.Context, req *pb.GetLineageRequest) (*pb.Lineage, error) {
+	var resp *api.LineageGetResponse
+	var err error
+
+	switch lookup := req.Lookup.(type) {
+	case *pb.GetLineageRequest_Id:
+		resp, err = s.servicer.GetLineageById(ctx, lookup.Id)
+	case *pb.GetLineageRequest_ExtId:
+		resp, err = s.servicer.GetLineage(ctx, lookup.ExtId)
+	case *pb.GetLineageRequest_Address:
+		if lookup.Address == nil || lookup.Address.ChainId < 1 {
+			return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+		}
+
+		resp, err = s.servicer.GetLineageByAddress(ctx, lookup.Address.ChainId, lookup.Address.Address)
+	default:
+		err = ticket.ErrInvalidRequest
+	}
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return toLineage(resp), nil
+}
+
+func (s *Server) UpdateLineage(ctx context.Context, req *pb.UpdateLineageRequest) (*pb.Lineage, error) {
+	if !validLabels(req.Labels) {
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	labels := api.Labels{}
+	for k, v := range req.Labels {
+		labels[k] = v
+	}
+
+	resp, err := s.servicer.UpdateLineage(ctx, req.LineageId, &api.LineageUpdateRequest{Labels: labels})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return toLineage(resp), nil
+}
+
+func (s *Server) ListLineages(ctx context.Context, req *pb.ListLineagesRequest) (*pb.ListLineagesResponse, error) {
+	if req.Limit < 0 || req.Limit > maxPageLimit {
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	resp, err := s.servicer.ListLineages(ctx, &api.ListLineagesParams{
+		LabelSelector: optional(req.LabelSelector),
+		Limit:         optional(int(req.Limit)),
+		Cursor:        optional(req.Cursor),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.ListLineagesResponse{
+		Lineages:   toLineages(resp.Lineages),
+		NextCursor: valueOrZero(resp.NextCursor),
+	}, nil
+}
+
+func (s *Server) GetLineageStats(ctx context.Context, req *pb.GetLineageStatsRequest) (*pb.LineageStats, error) {
+	resp, err := s.servicer.GetLineageStats(ctx, &api.GetLineageStatsParams{
+		LabelSelector: optional(req.LabelSelector),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.LineageStats{
+		LineageCount:        int32(resp.LineageCount),
+		LeasedNonceCount:    int32(resp.LeasedNonceCount),
+		ReleasedNonceCount:  int32(resp.ReleasedNonceCount),
+		ClosedNonceCount:    int32(resp.ClosedNonceCount),
+		MaxLeasedNonceCount: int32(resp.MaxLeasedNonceCount),
+	}, nil
+}
+
+func (s *Server) CloneLineage(ctx context.Context, req *pb.CloneLineageRequest) (*pb.Lineage, error) {
+	if !validLabels(req.Labels) {
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	var labels *api.Labels
+	if len(req.Labels) > 0 {
+		l := api.Labels(req.Labels)
+		labels = &l
+	}
+
+	resp, err := s.servicer.CloneLineage(ctx, req.LineageId, &api.LineageCloneRequest{
+		ExtId:          req.ExtId,
+		IncludeTickets: &req.IncludeTickets,
+		Labels:         labels,
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return toLineage(resp), nil
+}
+
+func (s *Server) ListLineageEvents(ctx context.Context, req *pb.ListLineageEventsRequest) (
+	*pb.ListLineageEventsResponse, error) {
+
+	if req.After < 0 || req.Limit < 0 || req.Limit > maxPageLimit {
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	resp, err := s.servicer.ListLineageEvents(ctx, req.LineageId, &api.ListLineageEventsParams{
+		After: optional(req.After),
+		Limit: optional(int(req.Limit)),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	events := make([]*pb.LineageEvent, 0, len(resp.Events))
+	for i := range resp.Events {
+		events = append(events, toLineageEvent(&resp.Events[i]))
+	}
+
+	return &pb.ListLineageEventsResponse{Events: events}, nil
+}
+
+func (s *Server) WatchLineageEvents(req *pb.WatchLineageEventsRequest,
+	stream pb.DinonceService_WatchLineageEventsServer) error {
+
+	ctx := stream.Context()
+
+	if req.After < 0 {
+		return toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	sub := s.servicer.SubscribeLineageEvents(ctx, req.LineageId)
+	defer sub.Close()
+
+	after := req.After
+	for {
+		resp, err := s.servicer.ListLineageEvents(ctx, req.LineageId, &api.ListLineageEventsParams{
+			After: &after,
+		})
+		if err != nil {
+			return toStatus(ctx, err)
+		}
+
+		for i := range resp.Events {
+			if err := stream.Send(toLineageEvent(&resp.Events[i])); err != nil {
+				return err
+			}
+			after = resp.Events[i].Seq
+		}
+
+		if len(resp.Events) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return toStatus(ctx, ctx.Err())
+		case <-sub.Wake():
+		}
+	}
+}
+
+func (s *Server) LeaseTickets(ctx context.Context, req *pb.LeaseTicketsRequest) (*pb.LeaseTicketsResponse, error) {
+	ctx = withExpectedVersion(ctx, req.IfMatchVersion)
+
+	if req.WaitSeconds > 0 {
+		wait := time.Duration(req.WaitSeconds) * time.Second
+		if wait > ticket.MaxWait {
+			return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+		}
+		ctx = ticket.WithWait(ctx, wait)
+	}
+
+	resp, err := s.servicer.LeaseTicket(ctx, req.LineageId, &api.TicketLeaseRequest{
+		ExtIds:     req.ExtIds,
+		Contiguous: &req.Contiguous,
+		Partial:    &req.Partial,
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	var rejected []*pb.TicketLeaseRejection
+	if resp.Rejected != nil {
+		for _, r := range *resp.Rejected {
+			rejected = append(rejected, &pb.TicketLeaseRejection{ExtId: r.ExtId, Code: string(r.Code)})
+		}
+	}
+
+	return &pb.LeaseTicketsResponse{
+		Tickets:  toTickets(resp.Leases),
+		Rejected: rejected,
+	}, nil
+}
+
+func (s *Server) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.Ticket, error) {
+	resp, err := s.servicer.GetTicket(ctx, req.LineageId, req.ExtId)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return singleTicket(ctx, resp)
+}
+
+func (s *Server) GetTickets(ctx context.Context, req *pb.GetTicketsRequest) (*pb.GetTicketsResponse, error) {
+	resp, err := s.servicer.GetTickets(ctx, req.LineageId, req.ExtIds, req.AllOrNothing)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.GetTicketsResponse{
+		Tickets:       toTickets(resp.Leases),
+		MissingExtIds: valueOrZero(resp.MissingExtIds),
+	}, nil
+}
+
+func (s *Server) ListTickets(ctx context.Context, req *pb.ListTicketsRequest) (*pb.ListTicketsResponse, error) {
+	state := api.GetTicketsParamsState(req.State)
+	switch state {
+	case "", api.GetTicketsParamsStateLeased, api.GetTicketsParamsStateClosed, api.GetTicketsParamsStateReplaced:
+	default:
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	if req.Limit < 0 || req.Limit > maxPageLimit || (req.MinNonce != nil && *req.MinNonce < 0) ||
+		(req.MaxNonce != nil && *req.MaxNonce < 0) {
+
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	resp, err := s.servicer.ListTickets(ctx, req.LineageId, &api.GetTicketsParams{
+		State:        optional(state),
+		LeasedAfter:  fromTimestamp(req.LeasedAfter),
+		LeasedBefore: fromTimestamp(req.LeasedBefore),
+		MinNonce:     req.MinNonce,
+		MaxNonce:     req.MaxNonce,
+		Limit:        optional(int(req.Limit)),
+		Cursor:       optional(req.Cursor),
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.ListTicketsResponse{
+		Tickets:    toTickets(resp.Leases),
+		NextCursor: valueOrZero(resp.NextCursor),
+	}, nil
+}
+
+func (s *Server) ReleaseTicket(ctx context.Context, req *pb.ReleaseTicketRequest) (*emptypb.Empty, error) {
+	ctx = withExpectedVersion(ctx, req.IfMatchVersion)
+
+	if err := s.servicer.ReleaseTicket(ctx, req.LineageId, req.ExtId); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) CloseTicket(ctx context.Context, req *pb.CloseTicketRequest) (*emptypb.Empty, error) {
+	ctx = withExpectedVersion(ctx, req.IfMatchVersion)
+
+	if err := s.servicer.CloseTicket(ctx, req.LineageId, req.ExtId); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) TransferTicket(ctx context.Context, req *pb.TransferTicketRequest) (*pb.Ticket, error) {
+	ctx = withExpectedVersion(ctx, req.IfMatchVersion)
+
+	resp, err := s.servicer.TransferTicket(ctx, req.LineageId, req.ExtId, &api.TicketTransferRequest{
+		ExtId: req.NewExtId,
+	})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return singleTicket(ctx, resp)
+}
+
+func (s *Server) UpdateTickets(ctx context.Context, req *pb.UpdateTicketsRequest) (*pb.UpdateTicketsResponse, error) {
+	if len(req.Tickets) < 1 || len(req.Tickets) > maxBulkUpdateCount {
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	items := make([]api.TicketBulkUpdateItem, 0, len(req.Tickets))
+	for _, t := range req.Tickets {
+		state := api.TicketBulkUpdateItemState(t.State)
+		if state != api.TicketBulkUpdateItemStateReleased && state != api.TicketBulkUpdateItemStateClosed {
+			return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+		}
+
+		items = append(items, api.TicketBulkUpdateItem{ExtId: t.ExtId, State: state})
+	}
+
+	ctx = withExpectedVersion(ctx, req.IfMatchVersion)
+
+	resp, err := s.servicer.UpdateTickets(ctx, req.LineageId, &api.TicketBulkUpdateRequest{Tickets: items})
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	results := make([]*pb.TicketUpdateResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		results = append(results, &pb.TicketUpdateResult{ExtId: r.ExtId, Outcome: string(r.Outcome)})
+	}
+
+	return &pb.UpdateTicketsResponse{Results: results}, nil
+}
+
+func (s *Server) GetTicketHistory(ctx context.Context, req *pb.GetTicketHistoryRequest) (
+	*pb.GetTicketHistoryResponse, error) {
+
+	resp, err := s.servicer.GetTicketHistory(ctx, req.LineageId, req.ExtId)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	entries := make([]*pb.TicketHistoryEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entries = append(entries, &pb.TicketHistoryEntry{
+			Action:    string(e.Action),
+			Nonce:     e.Nonce,
+			Actor:     e.Actor,
+			Timestamp: toTimestamp(&e.Timestamp),
+			Principal: e.Principal,
+		})
+	}
+
+	return &pb.GetTicketHistoryResponse{
+		LineageId: resp.LineageId,
+		ExtId:     resp.ExtId,
+		Entries:   entries,
+	}, nil
+}
+
+func (s *Server) GetNonce(ctx context.Context, req *pb.GetNonceRequest) (*pb.GetNonceResponse, error) {
+	if req.Nonce < 0 {
+		return nil, toStatus(ctx, ticket.ErrInvalidRequest)
+	}
+
+	resp, err := s.servicer.GetNonce(ctx, req.LineageId, req.Nonce)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+
+	return &pb.GetNonceResponse{
+		LineageId: resp.LineageId,
+		Nonce:     resp.Nonce,
+		Status:    string(resp.Status),
+		ExtId:     resp.ExtId,
+		LeasedAt:  toTimestamp(resp.LeasedAt),
+	}, nil
+}
+
+func singleTicket(ctx context.Context, resp *api.TicketLeaseResponse) (*pb.Ticket, error) {
+	tickets := toTickets(resp.Leases)
+	if len(tickets) == 0 {
+		return nil, toStatus(ctx, ticket.ErrNoSuchTicket)
+	}
+
+	return tickets[0], nil
+}
+
+// validLabels checks the labels against the limits of the Labels schema, their format is checked by the servicer.
+func validLabels(labels map[string]string) bool {
+	if len(labels) > maxLabelCount {
+		return false
+	}
+
+	for _, v := range labels {
+		if len(v) > maxLabelValueLength {
+			return false
+		}
+	}
+
+	return true
+}
+
+func withExpectedVersion(ctx context.Context, version *int64) context.Context {
+	if version == nil {
+		return ctx
+	}
+
+	return ticket.WithExpectedVersion(ctx, *version)
+}
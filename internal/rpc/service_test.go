@@ -0,0 +1,167 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	pb "github.com/welthee/dinonce/v2/internal/rpc/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recordingServicer counts the calls reaching the servicer, calls of methods it does not implement panic.
+type recordingServicer struct {
+	ticket.Servicer
+	calls int
+}
+
+func (r *recordingServicer) CreateLineage(context.Context, *api.LineageCreationRequest) (
+	*api.LineageCreationResponse, error) {
+
+	r.calls++
+	return &api.LineageCreationResponse{}, nil
+}
+
+func (r *recordingServicer) GetLineageByAddress(context.Context, int64, string) (*api.LineageGetResponse, error) {
+	r.calls++
+	return &api.LineageGetResponse{}, nil
+}
+
+func (r *recordingServicer) UpdateLineage(context.Context, string, *api.LineageUpdateRequest) (
+	*api.LineageGetResponse, error) {
+
+	r.calls++
+	return &api.LineageGetResponse{}, nil
+}
+
+func (r *recordingServicer) CloneLineage(context.Context, string, *api.LineageCloneRequest) (
+	*api.LineageGetResponse, error) {
+
+	r.calls++
+	return &api.LineageGetResponse{}, nil
+}
+
+func (r *recordingServicer) GetNonce(context.Context, string, int64) (*api.NonceGetResponse, error) {
+	r.calls++
+	return &api.NonceGetResponse{}, nil
+}
+
+func (r *recordingServicer) ListTickets(context.Context, string, *api.GetTicketsParams) (
+	*api.TicketLeaseResponse, error) {
+
+	r.calls++
+	return &api.TicketLeaseResponse{}, nil
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func labelsWithValue(value string) map[string]string {
+	return map[string]string{"env": value}
+}
+
+func tooManyLabels() map[string]string {
+	labels := map[string]string{}
+	for i := 0; i <= maxLabelCount; i++ {
+		labels["label"+strings.Repeat("x", i)] = "v"
+	}
+
+	return labels
+}
+
+func TestServer_Validation(t *testing.T) {
+	longValue := strings.Repeat("v", maxLabelValueLength+1)
+	address := &pb.GetLineageRequest_Address{Address: &pb.ChainAddress{ChainId: 0, Address: "0xabc"}}
+
+	tests := []struct {
+		name string
+		call func(s *Server) error
+	}{
+		{"create with zero chain id", func(s *Server) error {
+			_, err := s.CreateLineage(context.Background(), &pb.CreateLineageRequest{ExtId: "l",
+				MaxLeasedNonceCount: 1, ChainId: int64Ptr(0), Address: optional("0xabc")})
+			return err
+		}},
+		{"create with long label value", func(s *Server) error {
+			_, err := s.CreateLineage(context.Background(), &pb.CreateLineageRequest{ExtId: "l",
+				MaxLeasedNonceCount: 1, Labels: labelsWithValue(longValue)})
+			return err
+		}},
+		{"create with too many labels", func(s *Server) error {
+			_, err := s.CreateLineage(context.Background(), &pb.CreateLineageRequest{ExtId: "l",
+				MaxLeasedNonceCount: 1, Labels: tooManyLabels()})
+			return err
+		}},
+		{"get by zero chain id", func(s *Server) error {
+			_, err := s.GetLineage(context.Background(), &pb.GetLineageRequest{Lookup: address})
+			return err
+		}},
+		{"get without address", func(s *Server) error {
+			_, err := s.GetLineage(context.Background(), &pb.GetLineageRequest{Lookup: &pb.GetLineageRequest_Address{}})
+			return err
+		}},
+		{"update with long label value", func(s *Server) error {
+			_, err := s.UpdateLineage(context.Background(), &pb.UpdateLineageRequest{LineageId: "l",
+				Labels: labelsWithValue(longValue)})
+			return err
+		}},
+		{"clone with long label value", func(s *Server) error {
+			_, err := s.CloneLineage(context.Background(), &pb.CloneLineageRequest{LineageId: "l", ExtId: "c",
+				Labels: labelsWithValue(longValue)})
+			return err
+		}},
+		{"get negative nonce", func(s *Server) error {
+			_, err := s.GetNonce(context.Background(), &pb.GetNonceRequest{LineageId: "l", Nonce: -1})
+			return err
+		}},
+		{"list tickets from negative nonce", func(s *Server) error {
+			_, err := s.ListTickets(context.Background(), &pb.ListTicketsRequest{LineageId: "l",
+				MinNonce: int64Ptr(-1)})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		servicer := &recordingServicer{}
+		err := tt.call(newTestServer(servicer))
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected code %s, got %v", tt.name, codes.InvalidArgument, err)
+		}
+
+		if servicer.calls != 0 {
+			t.Errorf("%s: expected the servicer not to be called", tt.name)
+		}
+	}
+}
+
+func TestServer_Validation_Limits(t *testing.T) {
+	servicer := &recordingServicer{}
+	s := newTestServer(servicer)
+	value := strings.Repeat("v", maxLabelValueLength)
+
+	if _, err := s.CreateLineage(context.Background(), &pb.CreateLineageRequest{ExtId: "l", MaxLeasedNonceCount: 1,
+		ChainId: int64Ptr(1), Address: optional("0xabc"), Labels: labelsWithValue(value)}); err != nil {
+
+		t.Errorf("expected create to succeed, got %s", err)
+	}
+
+	if _, err := s.UpdateLineage(context.Background(), &pb.UpdateLineageRequest{LineageId: "l",
+		Labels: labelsWithValue(value)}); err != nil {
+
+		t.Errorf("expected update to succeed, got %s", err)
+	}
+
+	if _, err := s.CloneLineage(context.Background(), &pb.CloneLineageRequest{LineageId: "l", ExtId: "c",
+		Labels: labelsWithValue(value)}); err != nil {
+
+		t.Errorf("expected clone to succeed, got %s", err)
+	}
+
+	if servicer.calls != 3 {
+		t.Errorf("expected 3 servicer calls, got %d", servicer.calls)
+	}
+}
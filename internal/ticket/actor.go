@@ -0,0 +1,60 @@
+package ticket
+
+import (
+	"context"
+	"regexp"
+)
+
+// MaxActorLength is the longest actor recorded in the ticket history, longer actors are truncated.
+const MaxActorLength = 255
+
+// actorRegexp restricts actors to printable names, so they can be shown in logs and tables as they are.
+var actorRegexp = regexp.MustCompile(`^[a-zA-Z0-9 ._@:/+-]+$`)
+
+type actorContextKey struct{}
+
+type principalContextKey struct{}
+
+// WithActor returns a copy of ctx attributing the ticket changes of servicer calls to the given actor. The actor is
+// asserted by the caller, see WithPrincipal for the identity established by its credentials.
+func WithActor(ctx context.Context, actor string) context.Context {
+	if len(actor) > MaxActorLength {
+		actor = actor[:MaxActorLength]
+	}
+
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor ticket changes are attributed to, an empty string if none was set.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok {
+		return actor
+	}
+
+	return ""
+}
+
+// ValidateActor checks that an actor sent by a caller fits in the ticket history and only holds printable
+// characters.
+func ValidateActor(actor string) error {
+	if len(actor) > MaxActorLength || !actorRegexp.MatchString(actor) {
+		return ErrInvalidRequest
+	}
+
+	return nil
+}
+
+// WithPrincipal returns a copy of ctx recording the ticket changes of servicer calls as made with the credentials
+// identified by principal. Unlike the actor, the principal is derived from the credentials of the request.
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal ticket changes are made with, an empty string for anonymous callers.
+func PrincipalFromContext(ctx context.Context) string {
+	if principal, ok := ctx.Value(principalContextKey{}).(string); ok {
+		return principal
+	}
+
+	return ""
+}
@@ -0,0 +1,24 @@
+package ticket_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+func TestValidateActor(t *testing.T) {
+	for _, actor := range []string{"alice", "svc:signer-1", "Jane Doe", "ops@example.com", "team/bot+1"} {
+		if err := ticket.ValidateActor(actor); err != nil {
+			t.Errorf("expected actor %q to be valid, got %s", actor, err)
+		}
+	}
+}
+
+func TestValidateActor_Invalid(t *testing.T) {
+	for _, actor := range []string{"", "alice\nbob", "<script>", "tab\there", strings.Repeat("a", ticket.MaxActorLength+1)} {
+		if err := ticket.ValidateActor(actor); err != ticket.ErrInvalidRequest {
+			t.Errorf("expected actor %q to be invalid, got %v", actor, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package ticket
+
+import (
+	"regexp"
+	"strings"
+)
+
+var addressRegexp = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// NormalizeAddress validates a hex encoded account address and returns its lowercase form, so that EIP-55
+// checksummed and lowercase spellings of the same address are equal.
+func NormalizeAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if len(address) > 1 && address[1] == 'X' {
+		address = "0x" + address[2:]
+	}
+
+	if !addressRegexp.MatchString(address) {
+		return "", ErrInvalidRequest
+	}
+
+	return strings.ToLower(address), nil
+}
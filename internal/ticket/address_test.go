@@ -0,0 +1,41 @@
+package ticket_test
+
+import (
+	"testing"
+
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	expected := "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+	for _, a := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0X5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+		" 0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed ",
+	} {
+		normalized, err := ticket.NormalizeAddress(a)
+		if err != nil {
+			t.Errorf("can not normalize address %q %s", a, err)
+		}
+
+		if normalized != expected {
+			t.Errorf("expected %q to be normalized to %s, got %s", a, expected, normalized)
+		}
+	}
+}
+
+func TestNormalizeAddress_Invalid(t *testing.T) {
+	for _, a := range []string{
+		"",
+		"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beae",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaedd",
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaeg",
+	} {
+		if _, err := ticket.NormalizeAddress(a); err != ticket.ErrInvalidRequest {
+			t.Errorf("expected address %q to be invalid", a)
+		}
+	}
+}
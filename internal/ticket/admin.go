@@ -0,0 +1,25 @@
+package ticket
+
+import (
+	"context"
+	"errors"
+
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+)
+
+var ErrLineagePaused = errors.New("lineage is paused")
+
+// Administrator carries out the operator-only operations of the admin API. They bypass the rules executors are held
+// to, the ticket changes they make are recorded as forced in the ticket history. Every call is scoped to the namespace
+// carried by its context, see WithNamespace.
+type Administrator interface {
+	GetLineageState(ctx context.Context, lineageId string) (*admin.LineageState, error)
+
+	// PauseLineage makes leasing tickets of the lineage fail with ErrLineagePaused until it is called again with
+	// paused set to false.
+	PauseLineage(ctx context.Context, lineageId string, paused bool) (*admin.LineageState, error)
+	ResetLineage(ctx context.Context, lineageId string, request *admin.LineageResetRequest) (*admin.LineageState, error)
+	RepairLineage(ctx context.Context, lineageId string) (*admin.LineageRepairResponse, error)
+	ForceReleaseTicket(ctx context.Context, lineageId string, ticketExtId string) (*admin.ForcedTicket, error)
+	ForceCloseTicket(ctx context.Context, lineageId string, ticketExtId string) (*admin.ForcedTicket, error)
+}
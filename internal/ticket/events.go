@@ -0,0 +1,128 @@
+package ticket
+
+import (
+	"sync"
+
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+)
+
+// EventCursor is a position in the events of every lineage of a namespace. Events are ordered by the transaction
+// which recorded them and then by their id, both of which grow over time.
+type EventCursor struct {
+	TxId int64
+	Id   int64
+}
+
+// After reports whether the cursor is past other.
+func (c EventCursor) After(other EventCursor) bool {
+	return c.TxId > other.TxId || c.TxId == other.TxId && c.Id > other.Id
+}
+
+// CursoredLineageEvent is a lineage event together with its position in the events of the namespace.
+type CursoredLineageEvent struct {
+	Cursor EventCursor
+	Event  api.LineageEvent
+}
+
+// EventNotification tells that a committed transaction recorded events of a lineage.
+type EventNotification struct {
+	Namespace string
+	LineageId string
+	TxId      int64
+}
+
+// EventSubscriptions fans the notifications of new lineage events out to the streams waiting for them, so that
+// streams only read events once there are new ones.
+type EventSubscriptions struct {
+	mu   sync.Mutex
+	subs map[*EventSubscription]struct{}
+}
+
+// EventSubscription is woken whenever events of its lineages were committed.
+type EventSubscription struct {
+	namespace string
+	lineageId string
+	wake      chan struct{}
+	owner     *EventSubscriptions
+
+	mu   sync.Mutex
+	txId int64
+}
+
+func NewEventSubscriptions() *EventSubscriptions {
+	return &EventSubscriptions{subs: make(map[*EventSubscription]struct{})}
+}
+
+// Subscribe subscribes to the events of the lineage, or to the events of every lineage of the namespace if lineageId
+// is empty. The subscription has to be closed once done.
+func (s *EventSubscriptions) Subscribe(namespace string, lineageId string) *EventSubscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &EventSubscription{
+		namespace: namespace,
+		lineageId: lineageId,
+		wake:      make(chan struct{}, 1),
+		owner:     s,
+	}
+	s.subs[sub] = struct{}{}
+
+	return sub
+}
+
+// Notify wakes the subscriptions to the lineage of the notification.
+func (s *EventSubscriptions) Notify(n EventNotification) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subs {
+		if sub.namespace == n.Namespace && (sub.lineageId == "" || sub.lineageId == n.LineageId) {
+			sub.signal(n.TxId)
+		}
+	}
+}
+
+// NotifyAll wakes every subscription, to be called when notifications may have been lost. Events of transactions up
+// to txId may have been committed in the meantime.
+func (s *EventSubscriptions) NotifyAll(txId int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subs {
+		sub.signal(txId)
+	}
+}
+
+// Wake returns the channel receiving once events were committed since the last receive.
+func (s *EventSubscription) Wake() <-chan struct{} {
+	return s.wake
+}
+
+// TxId returns the newest transaction notified to have recorded events.
+func (s *EventSubscription) TxId() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.txId
+}
+
+// Close ends the subscription.
+func (s *EventSubscription) Close() {
+	s.owner.mu.Lock()
+	defer s.owner.mu.Unlock()
+
+	delete(s.owner.subs, s)
+}
+
+func (s *EventSubscription) signal(txId int64) {
+	s.mu.Lock()
+	if txId > s.txId {
+		s.txId = txId
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
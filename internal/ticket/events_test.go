@@ -0,0 +1,73 @@
+package ticket_test
+
+import (
+	"testing"
+
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+func TestEventSubscriptions_Notify(t *testing.T) {
+	subscriptions := ticket.NewEventSubscriptions()
+
+	lineage := subscriptions.Subscribe("tenant", "lineage")
+	defer lineage.Close()
+	namespace := subscriptions.Subscribe("tenant", "")
+	defer namespace.Close()
+	other := subscriptions.Subscribe("other", "")
+	defer other.Close()
+
+	subscriptions.Notify(ticket.EventNotification{Namespace: "tenant", LineageId: "lineage", TxId: 7})
+	subscriptions.Notify(ticket.EventNotification{Namespace: "tenant", LineageId: "lineage", TxId: 5})
+
+	for name, sub := range map[string]*ticket.EventSubscription{"lineage": lineage, "namespace": namespace} {
+		if !woken(sub) {
+			t.Errorf("expected subscription to the %s to be woken", name)
+		}
+		if woken(sub) {
+			t.Errorf("expected notifications of subscription to the %s to be coalesced", name)
+		}
+		if sub.TxId() != 7 {
+			t.Errorf("expected subscription to the %s to wait for transaction 7, got %d", name, sub.TxId())
+		}
+	}
+
+	if woken(other) {
+		t.Errorf("expected subscription to another namespace not to be woken")
+	}
+
+	subscriptions.Notify(ticket.EventNotification{Namespace: "tenant", LineageId: "another", TxId: 8})
+
+	if woken(lineage) {
+		t.Errorf("expected subscription to a lineage not to be woken by another lineage")
+	}
+	if !woken(namespace) {
+		t.Errorf("expected subscription to the namespace to be woken by another lineage")
+	}
+}
+
+func TestEventSubscriptions_NotifyAll(t *testing.T) {
+	subscriptions := ticket.NewEventSubscriptions()
+
+	sub := subscriptions.Subscribe("tenant", "lineage")
+	closed := subscriptions.Subscribe("other", "")
+	closed.Close()
+
+	subscriptions.NotifyAll(3)
+
+	if !woken(sub) || sub.TxId() != 3 {
+		t.Errorf("expected subscription to be woken waiting for transaction 3, got %d", sub.TxId())
+	}
+
+	if woken(closed) {
+		t.Errorf("expected closed subscription not to be woken")
+	}
+}
+
+func woken(sub *ticket.EventSubscription) bool {
+	select {
+	case <-sub.Wake():
+		return true
+	default:
+		return false
+	}
+}
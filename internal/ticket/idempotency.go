@@ -0,0 +1,22 @@
+package ticket
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyInProgress = errors.New("a request with the same idempotency key is in progress")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was used for a different request")
+)
+
+// IdempotencyKeyLockTimeout is how long a reserved idempotency key blocks retries of a request which did not
+// complete, e.g. because the server crashed while handling it.
+const IdempotencyKeyLockTimeout = time.Minute
+
+// IdempotentResponse is the first response sent for an idempotency key, which is replayed on retries.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,135 @@
+package ticket
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxLabelCount       = 64
+	maxLabelKeyLength   = 63
+	maxLabelValueLength = 255
+)
+
+var (
+	labelKeyRegexp   = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$`)
+	labelValueRegexp = regexp.MustCompile(`^[a-zA-Z0-9._:/-]*$`)
+)
+
+type LabelOperator string
+
+const (
+	LabelOperatorEquals       LabelOperator = "="
+	LabelOperatorNotEquals    LabelOperator = "!="
+	LabelOperatorExists       LabelOperator = "exists"
+	LabelOperatorDoesNotExist LabelOperator = "!"
+)
+
+// LabelRequirement is a single condition of a LabelSelector. Value is only set for the
+// equality based operators.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Value    string
+}
+
+// LabelSelector is a conjunction of label requirements.
+type LabelSelector []LabelRequirement
+
+// ParseLabelSelector parses a comma separated list of `key=value`, `key!=value`, `key` and `!key`
+// requirements. An empty selector matches every lineage.
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var requirements LabelSelector
+
+	if strings.TrimSpace(selector) == "" {
+		return requirements, nil
+	}
+
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+
+		var r LabelRequirement
+		switch {
+		case strings.Contains(term, "!="):
+			parts := strings.SplitN(term, "!=", 2)
+			r = LabelRequirement{Key: parts[0], Operator: LabelOperatorNotEquals, Value: parts[1]}
+		case strings.Contains(term, "=="):
+			parts := strings.SplitN(term, "==", 2)
+			r = LabelRequirement{Key: parts[0], Operator: LabelOperatorEquals, Value: parts[1]}
+		case strings.Contains(term, "="):
+			parts := strings.SplitN(term, "=", 2)
+			r = LabelRequirement{Key: parts[0], Operator: LabelOperatorEquals, Value: parts[1]}
+		case strings.HasPrefix(term, "!"):
+			r = LabelRequirement{Key: strings.TrimPrefix(term, "!"), Operator: LabelOperatorDoesNotExist}
+		default:
+			r = LabelRequirement{Key: term, Operator: LabelOperatorExists}
+		}
+
+		r.Key = strings.TrimSpace(r.Key)
+		r.Value = strings.TrimSpace(r.Value)
+
+		if err := validateLabel(r.Key, r.Value); err != nil {
+			return nil, fmt.Errorf("invalid label selector term %q: %w", term, err)
+		}
+
+		requirements = append(requirements, r)
+	}
+
+	return requirements, nil
+}
+
+// Matches reports whether the given labels satisfy every requirement of the selector.
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		value, ok := labels[r.Key]
+
+		switch r.Operator {
+		case LabelOperatorEquals:
+			if !ok || value != r.Value {
+				return false
+			}
+		case LabelOperatorNotEquals:
+			if ok && value == r.Value {
+				return false
+			}
+		case LabelOperatorExists:
+			if !ok {
+				return false
+			}
+		case LabelOperatorDoesNotExist:
+			if ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ValidateLabels checks label keys and values against the format accepted by label selectors.
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > maxLabelCount {
+		return fmt.Errorf("at most %d labels are allowed, got %d", maxLabelCount, len(labels))
+	}
+
+	for k, v := range labels {
+		if err := validateLabel(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateLabel(key string, value string) error {
+	if len(key) > maxLabelKeyLength || !labelKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid label key %q", key)
+	}
+
+	if len(value) > maxLabelValueLength || !labelValueRegexp.MatchString(value) {
+		return fmt.Errorf("invalid value %q for label %q", value, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package ticket_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	selector, err := ticket.ParseLabelSelector("chain=1, env!=staging,team,!deprecated,role==hot-wallet")
+	if err != nil {
+		t.Fatalf("can not parse label selector %s", err)
+	}
+
+	expected := ticket.LabelSelector{
+		{Key: "chain", Operator: ticket.LabelOperatorEquals, Value: "1"},
+		{Key: "env", Operator: ticket.LabelOperatorNotEquals, Value: "staging"},
+		{Key: "team", Operator: ticket.LabelOperatorExists},
+		{Key: "deprecated", Operator: ticket.LabelOperatorDoesNotExist},
+		{Key: "role", Operator: ticket.LabelOperatorEquals, Value: "hot-wallet"},
+	}
+
+	if !reflect.DeepEqual(selector, expected) {
+		t.Errorf("expected %v, got %v", expected, selector)
+	}
+}
+
+func TestParseLabelSelector_Empty(t *testing.T) {
+	selector, err := ticket.ParseLabelSelector(" ")
+	if err != nil {
+		t.Fatalf("can not parse empty label selector %s", err)
+	}
+
+	if len(selector) != 0 {
+		t.Errorf("expected empty selector, got %v", selector)
+	}
+}
+
+func TestParseLabelSelector_Invalid(t *testing.T) {
+	for _, s := range []string{"=value", "env=prod,", "!", "env=pr od", "-env=prod"} {
+		if _, err := ticket.ParseLabelSelector(s); err == nil {
+			t.Errorf("expected label selector %q to be invalid", s)
+		}
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	labels := map[string]string{"chain": "1", "env": "prod"}
+
+	cases := map[string]bool{
+		"":                 true,
+		"chain=1":          true,
+		"chain=1,env=prod": true,
+		"chain=2":          false,
+		"env!=staging":     true,
+		"env!=prod":        false,
+		"team!=payments":   true,
+		"env":              true,
+		"team":             false,
+		"!team":            true,
+		"!env":             false,
+	}
+
+	for s, expected := range cases {
+		selector, err := ticket.ParseLabelSelector(s)
+		if err != nil {
+			t.Fatalf("can not parse label selector %q %s", s, err)
+		}
+
+		if selector.Matches(labels) != expected {
+			t.Errorf("expected selector %q to match=%t", s, expected)
+		}
+	}
+}
+
+func TestValidateLabels(t *testing.T) {
+	if err := ticket.ValidateLabels(map[string]string{"chain": "1", "owner/team": "payments", "env": ""}); err != nil {
+		t.Errorf("expected labels to be valid %s", err)
+	}
+
+	if err := ticket.ValidateLabels(map[string]string{"bad key": "1"}); err == nil {
+		t.Errorf("expected label key with space to be invalid")
+	}
+
+	if err := ticket.ValidateLabels(map[string]string{"env": "a,b"}); err == nil {
+		t.Errorf("expected label value with comma to be invalid")
+	}
+}
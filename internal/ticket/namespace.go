@@ -0,0 +1,29 @@
+package ticket
+
+import "context"
+
+// DefaultNamespace scopes every lineage that was not created in an explicit namespace.
+const DefaultNamespace = "default"
+
+// Namespace isolates the lineages of a tenant. Zero limits are not enforced.
+type Namespace struct {
+	Name                string
+	MaxLineageCount     int
+	MaxLeasedNonceCount int
+}
+
+type namespaceContextKey struct{}
+
+// WithNamespace returns a copy of ctx scoping servicer calls to the given namespace.
+func WithNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceContextKey{}, namespace)
+}
+
+// NamespaceFromContext returns the namespace servicer calls are scoped to, DefaultNamespace if none was set.
+func NamespaceFromContext(ctx context.Context) string {
+	if namespace, ok := ctx.Value(namespaceContextKey{}).(string); ok {
+		return namespace
+	}
+
+	return DefaultNamespace
+}
@@ -0,0 +1,202 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+// Admin queries, the functions changing tickets run with the actor and principal of the request and are recorded as
+// forced in the ticket history
+const (
+	queryStringSelectLineageState = `select id, namespace, ext_id, next_nonce, leased_nonce_count, 
+released_nonce_count, closed_nonce_count, max_leased_nonce_count, version, paused 
+from lineages where id = $1 and namespace = $2`
+
+	queryStringForceReleaseTicket = `select force_release_ticket($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true), 
+set_config('dinonce.forced', 'true', true);`
+
+	queryStringForceCloseTicket = `select force_close_ticket($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true), 
+set_config('dinonce.forced', 'true', true);`
+
+	queryStringSetLineagePaused = `select set_lineage_paused($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true);`
+
+	queryStringResetLineage = `select reset_lineage($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true), 
+set_config('dinonce.forced', 'true', true);`
+
+	queryStringRepairLineage = `select repair_lineage($1, $2) 
+from set_config('dinonce.actor', $3, true), set_config('dinonce.principal', $4, true);`
+)
+
+// Administrator is the PostgreSQL backed ticket.Administrator. Leases waiting for a free ticket slot pick up slots
+// freed by it within the poll interval of their servicer.
+type Administrator struct {
+	db *sql.DB
+}
+
+func NewAdministrator(db *sql.DB) *Administrator {
+	var _ ticket.Administrator = &Administrator{}
+
+	return &Administrator{db: db}
+}
+
+func (a *Administrator) GetLineageState(ctx context.Context, lineageId string) (*admin.LineageState, error) {
+	state := &admin.LineageState{}
+	err := a.db.QueryRowContext(ctx, queryStringSelectLineageState, lineageId, ticket.NamespaceFromContext(ctx)).
+		Scan(&state.Id, &state.Namespace, &state.ExtId, &state.NextNonce, &state.LeasedNonceCount,
+			&state.ReleasedNonceCount, &state.ClosedNonceCount, &state.MaxLeasedNonceCount, &state.Version,
+			&state.Paused)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ticket.ErrNoSuchLineage
+		}
+
+		return nil, adminError(err)
+	}
+
+	return state, nil
+}
+
+func (a *Administrator) PauseLineage(ctx context.Context, lineageId string, paused bool) (*admin.LineageState, error) {
+	_, err := a.db.ExecContext(ctx, queryStringSetLineagePaused, ticket.NamespaceFromContext(ctx), lineageId, paused,
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx))
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	log.Ctx(ctx).Warn().
+		Str("lineageId", lineageId).
+		Bool("paused", paused).
+		Str("actor", ticket.ActorFromContext(ctx)).
+		Str("principal", ticket.PrincipalFromContext(ctx)).
+		Msg("set lineage paused")
+
+	return a.GetLineageState(ctx, lineageId)
+}
+
+func (a *Administrator) ResetLineage(ctx context.Context, lineageId string, request *admin.LineageResetRequest) (
+	*admin.LineageState, error) {
+
+	if request.NextNonce < 0 {
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	_, err := a.db.ExecContext(ctx, queryStringResetLineage, ticket.NamespaceFromContext(ctx), lineageId,
+		request.NextNonce, ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx))
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	log.Ctx(ctx).Warn().
+		Str("lineageId", lineageId).
+		Int64("nextNonce", request.NextNonce).
+		Str("actor", ticket.ActorFromContext(ctx)).
+		Str("principal", ticket.PrincipalFromContext(ctx)).
+		Msg("reset lineage")
+
+	return a.GetLineageState(ctx, lineageId)
+}
+
+func (a *Administrator) RepairLineage(ctx context.Context, lineageId string) (*admin.LineageRepairResponse, error) {
+	var before []int64
+	err := a.db.QueryRowContext(ctx, queryStringRepairLineage, ticket.NamespaceFromContext(ctx), lineageId,
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx)).
+		Scan(pq.Array(&before))
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	state, err := a.GetLineageState(ctx, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admin.LineageRepairResponse{
+		Before: admin.LineageCounters{
+			LeasedNonceCount:   int(before[0]),
+			ReleasedNonceCount: int(before[1]),
+			ClosedNonceCount:   int(before[2]),
+		},
+		Lineage: *state,
+	}
+
+	log.Ctx(ctx).Warn().
+		Str("lineageId", lineageId).
+		Interface("before", resp.Before).
+		Int("leasedNonceCount", state.LeasedNonceCount).
+		Int("releasedNonceCount", state.ReleasedNonceCount).
+		Int("closedNonceCount", state.ClosedNonceCount).
+		Str("actor", ticket.ActorFromContext(ctx)).
+		Str("principal", ticket.PrincipalFromContext(ctx)).
+		Msg("repaired lineage")
+
+	return resp, nil
+}
+
+func (a *Administrator) ForceReleaseTicket(ctx context.Context, lineageId string, ticketExtId string) (
+	*admin.ForcedTicket, error) {
+
+	return a.forceTicket(ctx, queryStringForceReleaseTicket, lineageId, ticketExtId, admin.Released)
+}
+
+func (a *Administrator) ForceCloseTicket(ctx context.Context, lineageId string, ticketExtId string) (
+	*admin.ForcedTicket, error) {
+
+	return a.forceTicket(ctx, queryStringForceCloseTicket, lineageId, ticketExtId, admin.Closed)
+}
+
+func (a *Administrator) forceTicket(ctx context.Context, query string, lineageId string, ticketExtId string,
+	state admin.ForcedTicketState) (*admin.ForcedTicket, error) {
+
+	var nonce int64
+	err := a.db.QueryRowContext(ctx, query, ticket.NamespaceFromContext(ctx), lineageId, ticketExtId,
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx)).
+		Scan(&nonce)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	log.Ctx(ctx).Warn().
+		Str("lineageId", lineageId).
+		Str("extId", ticketExtId).
+		Int64("nonce", nonce).
+		Str("state", string(state)).
+		Str("actor", ticket.ActorFromContext(ctx)).
+		Str("principal", ticket.PrincipalFromContext(ctx)).
+		Msg("forced ticket state")
+
+	return &admin.ForcedTicket{
+		LineageId: lineageId,
+		ExtId:     ticketExtId,
+		Nonce:     nonce,
+		State:     state,
+	}, nil
+}
+
+// adminError maps the errors raised by the admin functions to ticket errors.
+func adminError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		// 22P02 INVALID TEXT REPRESENTATION
+		case "22P02":
+			return ticket.ErrInvalidRequest
+		}
+
+		switch pqErr.Message {
+		case sqlErrMessageNoSuchLineage:
+			return ticket.ErrNoSuchLineage
+		case sqlErrMessageNoSuchTicket:
+			return ticket.ErrNoSuchTicket
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,165 @@
+package psql_test
+
+import (
+	"testing"
+
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+func leaseTickets(t *testing.T, lineageId string, extIds ...string) {
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: extIds})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+}
+
+func TestAdministrator_PauseLineage(t *testing.T) {
+	lineageId := createLineage(t)
+	leaseTickets(t, lineageId, "tx1")
+
+	state, err := adminVictim.PauseLineage(ticket.WithActor(ctx, "operator"), lineageId, true)
+	if err != nil {
+		t.Fatalf("can not pause lineage %s", err)
+	}
+	if !state.Paused {
+		t.Errorf("expected lineage to be paused")
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx2"}})
+	if err != ticket.ErrLineagePaused {
+		t.Fatalf("expected %s, got %v", ticket.ErrLineagePaused, err)
+	}
+
+	// leasing a ticket again returns its lease, and leased tickets can still be closed
+	leaseTickets(t, lineageId, "tx1")
+	if err := victim.CloseTicket(ctx, lineageId, "tx1"); err != nil {
+		t.Fatalf("can not close ticket of paused lineage %s", err)
+	}
+
+	if _, err := adminVictim.PauseLineage(ctx, lineageId, false); err != nil {
+		t.Fatalf("can not resume lineage %s", err)
+	}
+
+	leaseTickets(t, lineageId, "tx2")
+}
+
+func TestAdministrator_PauseLineage_NoSuchLineage(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := adminVictim.PauseLineage(ticket.WithNamespace(ctx, namespaceTenant), lineageId, true)
+	if err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected %s for lineage of another namespace, got %v", ticket.ErrNoSuchLineage, err)
+	}
+}
+
+func TestAdministrator_ForceReleaseTicket(t *testing.T) {
+	lineageId := createLineage(t)
+	leaseTickets(t, lineageId, "tx1", "tx2")
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	for _, extId := range []string{"tx1", "tx2"} {
+		resp, err := adminVictim.ForceReleaseTicket(ticket.WithActor(ctx, "operator"), lineageId, extId)
+		if err != nil {
+			t.Fatalf("can not force release ticket %s", err)
+		}
+		if resp.State != admin.Released {
+			t.Errorf("expected ticket to be released, got %s", resp.State)
+		}
+	}
+
+	state, err := adminVictim.GetLineageState(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not get lineage state %s", err)
+	}
+	if state.LeasedNonceCount != 2 || state.ReleasedNonceCount != 2 || state.ClosedNonceCount != 0 {
+		t.Errorf("expected both nonces to be released, got %+v", state)
+	}
+
+	history, err := victim.GetTicketHistory(ctx, lineageId, "tx2")
+	if err != nil {
+		t.Fatalf("can not get ticket history %s", err)
+	}
+	last := history.Entries[len(history.Entries)-1]
+	if last.Action != api.TicketHistoryEntryActionForceReleased || *last.Actor != "operator" {
+		t.Errorf("expected ticket to be force released by operator, got %v", last)
+	}
+
+	_, err = adminVictim.ForceReleaseTicket(ctx, lineageId, "tx1")
+	if err != ticket.ErrNoSuchTicket {
+		t.Errorf("expected %s, got %v", ticket.ErrNoSuchTicket, err)
+	}
+}
+
+func TestAdministrator_ForceCloseTicket(t *testing.T) {
+	lineageId := createLineage(t)
+	leaseTickets(t, lineageId, "tx1")
+
+	for i := 0; i < 2; i++ {
+		resp, err := adminVictim.ForceCloseTicket(ctx, lineageId, "tx1")
+		if err != nil {
+			t.Fatalf("can not force close ticket %s", err)
+		}
+		if resp.State != admin.Closed || resp.Nonce != 0 {
+			t.Errorf("expected nonce 0 to be closed, got %+v", resp)
+		}
+	}
+
+	state, err := adminVictim.GetLineageState(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not get lineage state %s", err)
+	}
+	if state.LeasedNonceCount != 0 || state.ClosedNonceCount != 1 {
+		t.Errorf("expected ticket to be closed once, got %+v", state)
+	}
+}
+
+func TestAdministrator_ResetLineage(t *testing.T) {
+	lineageId := createLineage(t)
+	leaseTickets(t, lineageId, "tx0", "tx1", "tx2", "tx3")
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx0"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+	if err := victim.CloseTicket(ctx, lineageId, "tx3"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	state, err := adminVictim.ResetLineage(ctx, lineageId, &admin.LineageResetRequest{NextNonce: 2})
+	if err != nil {
+		t.Fatalf("can not reset lineage %s", err)
+	}
+
+	// tx1 stays leased, tx2 and tx3 are dropped and the released nonce 0 is discarded
+	if state.NextNonce != 2 || state.LeasedNonceCount != 1 || state.ReleasedNonceCount != 0 ||
+		state.ClosedNonceCount != 0 {
+
+		t.Errorf("unexpected lineage state after reset %+v", state)
+	}
+
+	resp, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx4"}})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+	if nonce := ensureAndGetSingleNonce(t, resp); nonce != 2 {
+		t.Errorf("expected nonce 2 after reset, got %d", nonce)
+	}
+}
+
+func TestAdministrator_RepairLineage(t *testing.T) {
+	lineageId := createLineage(t)
+	leaseTickets(t, lineageId, "tx1", "tx2")
+
+	resp, err := adminVictim.RepairLineage(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not repair lineage %s", err)
+	}
+
+	if resp.Before.LeasedNonceCount != 2 || resp.Lineage.LeasedNonceCount != 2 {
+		t.Errorf("expected consistent counters to be kept, got %+v", resp)
+	}
+}
@@ -0,0 +1,103 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+// lineageEventsChannel is notified by every transaction which recorded lineage events, see the
+// lineage_events_notify_trg trigger.
+const lineageEventsChannel = "lineage_events"
+
+// Event listener constants
+const (
+	eventListenerMinReconnectInterval = 100 * time.Millisecond
+	eventListenerMaxReconnectInterval = 10 * time.Second
+	// eventListenerPingInterval is how often an idle listener checks its connection, so that a broken connection is
+	// reestablished even if no notifications come in.
+	eventListenerPingInterval = 90 * time.Second
+)
+
+const queryStringSelectNextTxId = `select txid_snapshot_xmax(txid_current_snapshot())`
+
+// lineageEventsNotification is the payload of the notifications of lineageEventsChannel.
+type lineageEventsNotification struct {
+	TxId      int64  `json:"txId"`
+	LineageId string `json:"lineageId"`
+	Namespace string `json:"namespace"`
+}
+
+// ListenLineageEvents wakes the subscriptions to the lineages of the events committed in the database, until ctx is
+// done. It holds one connection however many streams are subscribed. Notifications sent while the connection was
+// broken are lost, every subscription is woken once it has been reestablished.
+func ListenLineageEvents(ctx context.Context, connectionString string, db *sql.DB,
+	subscriptions *ticket.EventSubscriptions) error {
+
+	listener := pq.NewListener(connectionString, eventListenerMinReconnectInterval, eventListenerMaxReconnectInterval,
+		func(event pq.ListenerEventType, err error) {
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("lineage event listener connection failed")
+			}
+		})
+	defer func() {
+		if err := listener.Close(); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("can not close lineage event listener")
+		}
+	}()
+
+	if err := listener.Listen(lineageEventsChannel); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(eventListenerPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-listener.Notify:
+			if n == nil {
+				subscriptions.NotifyAll(getLastTxId(ctx, db))
+				continue
+			}
+
+			var payload lineageEventsNotification
+			if err := json.Unmarshal([]byte(n.Extra), &payload); err != nil {
+				log.Ctx(ctx).Error().Err(err).Str("payload", n.Extra).Msg("can not read lineage events notification")
+				continue
+			}
+
+			subscriptions.Notify(ticket.EventNotification{
+				Namespace: payload.Namespace,
+				LineageId: payload.LineageId,
+				TxId:      payload.TxId,
+			})
+		case <-ticker.C:
+			go func() {
+				if err := listener.Ping(); err != nil {
+					log.Ctx(ctx).Warn().Err(err).Msg("lineage event listener ping failed")
+				}
+			}()
+		}
+	}
+}
+
+// getLastTxId returns the id of the newest transaction which may have committed events. Without it every stream
+// rereads its events once, waiting for no transaction in particular.
+func getLastTxId(ctx context.Context, db *sql.DB) int64 {
+	var next int64
+	if err := db.QueryRowContext(ctx, queryStringSelectNextTxId).Scan(&next); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("can not get last transaction id")
+
+		return 0
+	}
+
+	return next - 1
+}
@@ -3,9 +3,14 @@ package psql
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,6 +21,13 @@ import (
 	"github.com/welthee/dinonce/v2/internal/ticket"
 )
 
+// Listing constants
+const (
+	lineageListDefaultLimit = 100
+	ticketListDefaultLimit  = 100
+	eventListDefaultLimit   = 100
+)
+
 // Optimistic lock retry constants
 const (
 	optimisticLockMaxRetryAttempts  = 5
@@ -24,53 +36,186 @@ const (
 	optimisticLockSleepMax          = 1 * time.Second
 )
 
+// waitPollInterval is how often the first lease waiting for a free slot of a lineage retries without being notified,
+// picking up slots freed by other instances.
+const waitPollInterval = 1 * time.Second
+
 // SQL Custom Errors
 const (
-	sqlErrConstraintLineagesExtIdx = "lineages_ext_id_idx"
+	sqlErrConstraintLineagesNamespaceExtIdx          = "lineages_namespace_ext_id_idx"
+	sqlErrConstraintLineagesNamespaceChainAddressIdx = "lineages_namespace_chain_id_address_idx"
 
 	sqlErrMessageValidationError        = "validation_error"
 	sqlErrMessageMaxUnusedLimitExceeded = "max_unused_limit_exceeded"
 	sqlErrMessageOptimisticLock         = "optimistic_lock"
 	sqlErrMessageNoSuchTicket           = "no_such_ticket"
 	sqlErrMessageAlreadyClosed          = "already_closed"
+	sqlErrMessageNoSuchLineage          = "no_such_lineage"
+	sqlErrMessageNamespaceLimitExceeded = "namespace_limit_exceeded"
+	sqlErrMessageNotContiguous          = "not_contiguous"
+	sqlErrMessageLineagePaused          = "lineage_paused"
 )
 
 // Queries
 const (
-	queryStringInsertLineage = `insert into lineages(id, ext_id, next_nonce, leased_nonce_count, 
-released_nonce_count, max_leased_nonce_count, max_nonce_value, version) 
-values ($1, $2, $3, 0, 0, $4, 9223372036854775807, 0) 
-returning id;`
+	queryStringCreateLineage = `select create_lineage($1, $2, $3, $4, $5, $6, $7, $8, $9);`
+
+	lineageColumns = `id, ext_id, next_nonce, leased_nonce_count, released_nonce_count, closed_nonce_count, 
+max_leased_nonce_count, max_nonce_value, version, created_at, labels, namespace, start_leasing_from, chain_id, address`
+
+	queryStringSelectLineageByExtId = `select ` + lineageColumns + ` from lineages where namespace = $1 and ext_id = $2`
+
+	queryStringSelectLineageById = `select ` + lineageColumns + ` from lineages where id = $1 and namespace = $2`
+
+	queryStringSelectLineageByAddress = `select ` + lineageColumns + ` from lineages 
+where namespace = $1 and chain_id = $2 and address = $3`
+
+	// the version covers the whole lineage, so its ETag changes with its labels too
+	queryStringUpdateLineageLabels = `update lineages 
+set labels = $3, version = version + case when labels is distinct from $3 then 1 else 0 end 
+where id = $1 and namespace = $2 returning ` + lineageColumns
+
+	queryStringSelectLineages = `select ` + lineageColumns + ` from lineages 
+where namespace = $1 and ($2::character varying is null or ext_id > $2)`
+
+	queryStringSelectLineageStats = `select count(*), coalesce(sum(leased_nonce_count), 0), 
+coalesce(sum(released_nonce_count), 0), coalesce(sum(closed_nonce_count), 0), 
+coalesce(sum(max_leased_nonce_count), 0) from lineages where namespace = $1`
+
+	// functions changing tickets run with the actor and principal of the request, which are recorded in the ticket
+	// history
+	queryStringCloneLineage = `select clone_lineage($1, $2, $3, $4, $5, $6, $7) 
+from set_config('dinonce.actor', $8, true), set_config('dinonce.principal', $9, true);`
+
+	queryStringCreateTicket = `select create_ticket($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true);`
+
+	queryStringCreateContiguousTickets = `select create_contiguous_tickets($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true);`
+
+	queryStringReleaseTicket = `select release_ticket($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true);`
+
+	queryStringCloseTicket = `select close_ticket($1, $2, $3) 
+from set_config('dinonce.actor', $4, true), set_config('dinonce.principal', $5, true);`
 
-	queryStringSelectLineageByExtId = `select id, next_nonce, leased_nonce_count, 
-released_nonce_count, max_leased_nonce_count, max_nonce_value, version from lineages where ext_id = $1`
+	queryStringUpdateTickets = `select update_tickets($1, $2, $3, $4) 
+from set_config('dinonce.actor', $5, true), set_config('dinonce.principal', $6, true);`
 
-	queryStringCreateTicket = `select create_ticket($1, $2, $3);`
+	queryStringTransferTicket = `select transfer_ticket($1, $2, $3, $4) 
+from set_config('dinonce.actor', $5, true), set_config('dinonce.principal', $6, true);`
 
-	queryStringReleaseTicket = `select release_ticket($1, $2, $3);`
+	queryStringSelectLineageVersion = `select version from lineages where id = $1 and namespace = $2;`
 
-	queryStringCloseTicket = `select close_ticket($1, $2, $3);`
+	queryStringSelectLineageCapacity = `select version, leased_nonce_count, released_nonce_count, max_leased_nonce_count
+from lineages where id = $1 and namespace = $2`
 
-	queryStringSelectLineageVersion = `select version from lineages where id = $1;`
+	queryStringSelectTicket = `select t.nonce, t.lease_status from tickets t 
+join lineages l on l.id = t.lineage_id 
+where t.lineage_id = $1 and l.namespace = $2 and t.ext_id = $3`
 
-	queryStringSelectTicket = `select nonce, lease_status from tickets where lineage_id = $1 and ext_id = $2`
+	queryStringSelectTickets = `select t.ext_id, t.nonce, t.lease_status from tickets t 
+join lineages l on l.id = t.lineage_id 
+where t.lineage_id = $1 and l.namespace = $2 and t.ext_id = any($3)`
 
-	queryStringSelectTickets = `select ext_id, nonce, lease_status from tickets where lineage_id = $1 and ext_id = any($2)`
+	queryStringSelectNonce = `select l.next_nonce, t.ext_id, t.lease_status, t.leased_at, r.nonce is not null 
+from lineages l 
+left join tickets t on t.lineage_id = l.id and t.nonce = $3 and t.lease_status <> 'replaced' 
+left join released_tickets r on r.lineage_id = l.id and r.nonce = $3 
+where l.id = $1 and l.namespace = $2`
+
+	queryStringSelectTicketHistory = `select h.nonce, h.action, h.actor, h.principal, h.created_at 
+from ticket_history h 
+join lineages l on l.id = h.lineage_id 
+where h.lineage_id = $1 and l.namespace = $2 and h.ext_id = $3 
+order by h.id`
+
+	queryStringSelectLineageEventSeq = `select event_seq from lineages where id = $1 and namespace = $2`
+
+	queryStringSelectEventHorizon = `select txid_snapshot_xmin(txid_current_snapshot())`
+
+	queryStringSelectLineagesEvents = `select e.txid, e.id, e.lineage_id, e.seq, e.type, e.ext_id, e.nonce, e.actor, 
+e.created_at from lineage_events e 
+join lineages l on l.id = e.lineage_id 
+where l.namespace = $1 and (e.txid, e.id) > ($2, $3) and e.txid < $4`
+
+	queryStringSelectLineageEvents = `select e.seq, e.type, e.ext_id, e.nonce, e.actor, e.created_at from lineage_events e 
+join lineages l on l.id = e.lineage_id 
+where e.lineage_id = $1 and l.namespace = $2 and e.seq > $3 
+order by e.seq limit $4`
+
+	queryStringDeleteExpiredIdempotencyKeys = `delete from idempotency_keys where lineage_id = $1 and expires_at < now()`
+
+	queryStringReserveIdempotencyKey = `insert into idempotency_keys(lineage_id, key, fingerprint, expires_at) 
+values ($1, $2, $3, now() + $4 * interval '1 millisecond') 
+on conflict (lineage_id, key) do update set fingerprint = excluded.fingerprint, status_code = null, 
+content_type = null, body = null, created_at = now(), expires_at = excluded.expires_at 
+where idempotency_keys.expires_at < now() 
+returning lineage_id`
+
+	queryStringSelectIdempotencyKey = `select fingerprint, status_code, content_type, body from idempotency_keys 
+where lineage_id = $1 and key = $2`
+
+	queryStringCompleteIdempotencyKey = `update idempotency_keys 
+set status_code = $3, content_type = $4, body = $5, expires_at = now() + $6 * interval '1 millisecond' 
+where lineage_id = $1 and key = $2`
+
+	queryStringReleaseIdempotencyKey = `delete from idempotency_keys 
+where lineage_id = $1 and key = $2 and status_code is null`
+
+	queryStringSelectTicketPage = `select t.ext_id, t.nonce, t.lease_status, t.leased_at from tickets t 
+join lineages l on l.id = t.lineage_id 
+where t.lineage_id = $1 and l.namespace = $2`
 )
 
 type Servicer struct {
-	db *sql.DB
+	db         *sql.DB
+	namespaces map[string]ticket.Namespace
+	waiters    *ticket.Waiters
+	events     *ticket.EventSubscriptions
 }
 
-func NewServicer(db *sql.DB) ticket.Servicer {
+// NewServicer creates a PostgreSQL backed servicer. Besides ticket.DefaultNamespace, which is always available,
+// only the given namespaces can be used. Event streams are woken through events, see ListenLineageEvents.
+func NewServicer(db *sql.DB, events *ticket.EventSubscriptions, namespaces ...ticket.Namespace) ticket.Servicer {
+	nsMap := map[string]ticket.Namespace{
+		ticket.DefaultNamespace: {Name: ticket.DefaultNamespace},
+	}
+	for _, ns := range namespaces {
+		nsMap[ns.Name] = ns
+	}
+
 	return &Servicer{
-		db: db,
+		db:         db,
+		namespaces: nsMap,
+		waiters:    ticket.NewWaiters(waitPollInterval),
+		events:     events,
 	}
 }
 
 func (p *Servicer) CreateLineage(ctx context.Context, request *api.LineageCreationRequest) (
 	*api.LineageCreationResponse, error) {
 
+	namespace, ok := p.namespaces[ticket.NamespaceFromContext(ctx)]
+	if !ok {
+		return nil, ticket.ErrNoSuchNamespace
+	}
+
+	if namespace.MaxLeasedNonceCount > 0 && request.MaxLeasedNonceCount > namespace.MaxLeasedNonceCount {
+		log.Ctx(ctx).Info().
+			Str("namespace", namespace.Name).
+			Str("extId", request.ExtId).
+			Int("maxLeasedNonceCount", request.MaxLeasedNonceCount).
+			Msg("can not create lineage, max leased nonce count exceeds namespace limit")
+
+		return nil, ticket.ErrNamespaceLimitExceeded
+	}
+
+	var maxLineageCount *int
+	if namespace.MaxLineageCount > 0 {
+		maxLineageCount = &namespace.MaxLineageCount
+	}
+
 	aUuid, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -81,312 +226,1056 @@ func (p *Servicer) CreateLineage(ctx context.Context, request *api.LineageCreati
 		request.StartLeasingFrom = &zero
 	}
 
-	rows, err := p.db.QueryContext(ctx, queryStringInsertLineage,
-		aUuid.String(), request.ExtId, request.StartLeasingFrom, request.MaxLeasedNonceCount)
-	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Constraint {
-			case sqlErrConstraintLineagesExtIdx:
-				return nil, ticket.ErrInvalidRequest
-			default:
-				return nil, err
-			}
+	if (request.ChainId == nil) != (request.Address == nil) {
+		log.Ctx(ctx).Info().
+			Str("extId", request.ExtId).
+			Msg("can not create lineage, chainId and address must be set together")
+
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	if request.Address != nil {
+		address, err := ticket.NormalizeAddress(*request.Address)
+		if err != nil {
+			log.Ctx(ctx).Info().
+				Str("extId", request.ExtId).
+				Str("address", *request.Address).
+				Msg("can not create lineage with invalid address")
+
+			return nil, err
 		}
-		return nil, err
+		request.Address = &address
 	}
-	defer rowClose(ctx, rows)
 
-	if !rows.Next() {
-		return nil, errors.New("expected newly created lineage_id in result set")
+	labels := api.Labels{}
+	if request.Labels != nil {
+		labels = *request.Labels
 	}
 
-	var lineageId string
-	if err := rows.Scan(&lineageId); err != nil {
+	if err := ticket.ValidateLabels(labels); err != nil {
+		log.Ctx(ctx).Info().
+			Str("extId", request.ExtId).
+			Err(err).
+			Msg("can not create lineage with invalid labels")
+
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	labelsJson, err := json.Marshal(labels)
+	if err != nil {
 		return nil, err
 	}
 
+	var lineageId sql.NullString
+	err = p.db.QueryRowContext(ctx, queryStringCreateLineage,
+		namespace.Name, aUuid.String(), request.ExtId, request.StartLeasingFrom, request.MaxLeasedNonceCount,
+		string(labelsJson), request.ChainId, request.Address, maxLineageCount).
+		Scan(&lineageId)
+	if err != nil {
+		return nil, insertLineageError(err)
+	}
+
+	if !lineageId.Valid {
+		return p.getExistingLineage(ctx, namespace, request)
+	}
+
 	resp := &api.LineageCreationResponse{
-		Id:    lineageId,
+		Id:    lineageId.String,
 		ExtId: request.ExtId,
 	}
 
 	log.Ctx(ctx).Info().
-		Str("id", lineageId).
+		Str("id", lineageId.String).
+		Str("namespace", namespace.Name).
 		Str("extId", request.ExtId).
 		Msg("created lineage")
 
 	return resp, nil
 }
 
-func (p *Servicer) GetLineage(ctx context.Context, extId string) (*api.LineageGetResponse, error) {
-	var id string
-	var nextNonce int
-	var leasedNonceCount int
-	var releasedNonceCount int
-	var maxLeasedNonceCount int
-	var maxNonceValue int
-	var version int
-
-	err := p.db.QueryRowContext(ctx, queryStringSelectLineageByExtId, extId).
-		Scan(&id, &nextNonce, &leasedNonceCount,
-			&releasedNonceCount, &maxLeasedNonceCount, &maxNonceValue, &version)
+// getExistingLineage resolves a lineage creation request which did not insert a new lineage, either because a
+// lineage with the same extId already exists or because the namespace is full.
+func (p *Servicer) getExistingLineage(ctx context.Context, namespace ticket.Namespace,
+	request *api.LineageCreationRequest) (*api.LineageCreationResponse, error) {
+
+	existing, err := scanLineage(p.db.QueryRowContext(ctx, queryStringSelectLineageByExtId,
+		namespace.Name, request.ExtId))
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, ticket.ErrNoSuchLineage
+		if err == ticket.ErrNoSuchLineage {
+			log.Ctx(ctx).Info().
+				Str("namespace", namespace.Name).
+				Str("extId", request.ExtId).
+				Msg("can not create lineage, namespace lineage count limit reached")
+
+			return nil, ticket.ErrNamespaceLimitExceeded
 		}
 
 		return nil, err
 	}
 
-	resp := &api.LineageGetResponse{
-		Id:                  id,
-		ExtId:               extId,
-		NextNonce:           nextNonce,
-		LeasedNonceCount:    leasedNonceCount,
-		ReleasedNonceCount:  releasedNonceCount,
-		MaxLeasedNonceCount: maxLeasedNonceCount,
-		MaxNonceValue:       maxNonceValue,
+	if existing.MaxLeasedNonceCount != request.MaxLeasedNonceCount ||
+		(existing.StartLeasingFrom != nil && *existing.StartLeasingFrom != *request.StartLeasingFrom) ||
+		!equalPtr(existing.ChainId, request.ChainId) || !equalPtr(existing.Address, request.Address) {
+
+		log.Ctx(ctx).Info().
+			Str("lineageId", existing.Id).
+			Str("namespace", namespace.Name).
+			Str("extId", request.ExtId).
+			Msg("can not create lineage, a lineage with a different configuration already exists")
+
+		return nil, ticket.ErrLineageConflict
 	}
 
 	log.Ctx(ctx).Info().
-		Str("lineageId", id).
-		Str("extId", extId).
-		Int("version", version).
-		Msg("retrieved lineage")
+		Str("id", existing.Id).
+		Str("namespace", namespace.Name).
+		Str("extId", request.ExtId).
+		Msg("lineage already exists")
 
-	return resp, nil
+	return &api.LineageCreationResponse{
+		Id:    existing.Id,
+		ExtId: existing.ExtId,
+	}, nil
 }
 
-func (p *Servicer) LeaseTicket(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) (*api.TicketLeaseResponse, error) {
-	var err error
-	shouldRetry := true
-	var nonces []int64
+func equalPtr[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
 
-	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
-		nonces, shouldRetry, err = p.tryLeaseTicket(ctx, lineageId, request)
-		if err != nil {
-			if shouldRetry {
-				log.Ctx(ctx).Info().
-					Str("lineageId", lineageId).
-					Strs("extId", request.ExtIds).
-					Msg("retrying to lease ticket")
+	return *a == *b
+}
 
-				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
-			} else {
-				return nil, err
-			}
+func insertLineageError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Constraint {
+		case sqlErrConstraintLineagesNamespaceExtIdx, sqlErrConstraintLineagesNamespaceChainAddressIdx:
+			return ticket.ErrLineageConflict
 		}
 	}
 
-	var leases []api.TicketLease
-	for i, n := range nonces {
-		l := api.TicketLease{
-			LineageId: lineageId,
-			Nonce:     int(n), //TODO - check correct cast
-			ExtId:     request.ExtIds[i],
-			State:     api.TicketLeaseStateLeased,
-		}
-
-		leases = append(leases, l)
-	}
+	return err
+}
 
-	resp := &api.TicketLeaseResponse{
-		Leases: &leases,
+func (p *Servicer) GetLineage(ctx context.Context, extId string) (*api.LineageGetResponse, error) {
+	resp, err := scanLineage(p.db.QueryRowContext(ctx, queryStringSelectLineageByExtId,
+		ticket.NamespaceFromContext(ctx), extId))
+	if err != nil {
+		return nil, err
 	}
 
 	log.Ctx(ctx).Info().
-		Str("lineageId", lineageId).
-		Strs("extId", request.ExtIds).
-		Ints64("nonce", nonces).
-		Msg("leased tickets")
+		Str("lineageId", resp.Id).
+		Str("extId", extId).
+		Int("version", resp.Version).
+		Msg("retrieved lineage")
 
 	return resp, nil
 }
 
-func (p *Servicer) tryLeaseTicket(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) ([]int64, bool, error) {
-	version, err := p.getLineageVersion(ctx, lineageId)
+func (p *Servicer) GetLineageById(ctx context.Context, lineageId string) (*api.LineageGetResponse, error) {
+	resp, err := scanLineage(p.db.QueryRowContext(ctx, queryStringSelectLineageById,
+		lineageId, ticket.NamespaceFromContext(ctx)))
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
-	rows, err := p.db.QueryContext(ctx, queryStringCreateTicket, lineageId, version, pq.Array(request.ExtIds))
-	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code {
-			// 22P02 INVALID TEXT REPRESENTATION
-			case "22P02":
-				log.Ctx(ctx).Error().
-					Str("lineageId", lineageId).
-					Strs("extIds", request.ExtIds).
-					Str("pqErr Where", pqErr.Where).
-					Err(err).
-					Msg("")
-				return nil, false, ticket.ErrInvalidRequest
-			}
-
-			switch pqErr.Message {
-			case sqlErrMessageValidationError:
-				return nil, false, ticket.ErrInvalidRequest
-			case sqlErrMessageMaxUnusedLimitExceeded:
-				log.Ctx(ctx).Info().
-					Str("lineageId", lineageId).
-					Strs("extId", request.ExtIds).
-					Msg("can not lease ticket, too many leased tickets in lineage")
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Str("extId", resp.ExtId).
+		Int("version", resp.Version).
+		Msg("retrieved lineage")
 
-				return nil, false, ticket.ErrTooManyLeasedTickets
-			case sqlErrMessageOptimisticLock:
-				log.Ctx(ctx).Debug().
-					Str("lineageId", lineageId).
-					Strs("extId", request.ExtIds).
-					Msg("can not lease ticket due to too many concurrent requests(optimistic lock)")
+	return resp, nil
+}
 
-				return nil, true, ticket.ErrTooManyConcurrentRequests
-			default:
-				log.Ctx(ctx).Error().
-					Err(err).
-					Msg("can not lease due to unhandled error")
+func (p *Servicer) GetLineageByAddress(ctx context.Context, chainId int64, address string) (
+	*api.LineageGetResponse, error) {
 
-				return nil, false, err
-			}
-		}
-		return nil, false, err
+	normalized, err := ticket.NormalizeAddress(address)
+	if err != nil {
+		return nil, err
 	}
-	defer rowClose(ctx, rows)
 
-	nonces, err := getNoncesFromRow(rows)
+	resp, err := scanLineage(p.db.QueryRowContext(ctx, queryStringSelectLineageByAddress,
+		ticket.NamespaceFromContext(ctx), chainId, normalized))
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
-	return nonces, false, nil
-}
+	log.Ctx(ctx).Info().
+		Str("lineageId", resp.Id).
+		Int64("chainId", chainId).
+		Str("address", normalized).
+		Msg("retrieved lineage")
 
-func (p *Servicer) GetTicket(ctx context.Context, lineageId string, ticketExtId string) (*api.TicketLeaseResponse, error) {
-	var nonce int
-	var stateStr string
+	return resp, nil
+}
 
-	row := p.db.QueryRowContext(ctx, queryStringSelectTicket, lineageId, ticketExtId)
+func (p *Servicer) UpdateLineage(ctx context.Context, lineageId string, request *api.LineageUpdateRequest) (
+	*api.LineageGetResponse, error) {
 
-	if err := row.Err(); err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code {
-			// 22P02 INVALID TEXT REPRESENTATION
-			case "22P02":
-				return nil, ticket.ErrInvalidRequest
-			}
-		}
+	if err := ticket.ValidateLabels(request.Labels); err != nil {
+		log.Ctx(ctx).Info().
+			Str("lineageId", lineageId).
+			Err(err).
+			Msg("can not update lineage with invalid labels")
 
-		return nil, err
+		return nil, ticket.ErrInvalidRequest
 	}
 
-	if err := row.Scan(&nonce, &stateStr); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, ticket.ErrNoSuchTicket
-		}
-
+	labelsJson, err := json.Marshal(request.Labels)
+	if err != nil {
 		return nil, err
 	}
 
-	resp := &api.TicketLeaseResponse{
-		Leases: &[]api.TicketLease{
-			{
-				ExtId:     ticketExtId,
-				LineageId: lineageId,
-				Nonce:     nonce,
-				State:     api.TicketLeaseState(stateStr),
-			},
-		},
+	resp, err := scanLineage(p.db.QueryRowContext(ctx, queryStringUpdateLineageLabels,
+		lineageId, ticket.NamespaceFromContext(ctx), string(labelsJson)))
+	if err != nil {
+		return nil, err
 	}
 
 	log.Ctx(ctx).Info().
 		Str("lineageId", lineageId).
-		Str("extId", ticketExtId).
-		Msg("retrieved ticket")
+		Interface("labels", request.Labels).
+		Msg("updated lineage labels")
 
 	return resp, nil
 }
 
-func (p *Servicer) ReleaseTicket(ctx context.Context, lineageId string, ticketExtId string) error {
-	var err error
-	shouldRetry := true
+func (p *Servicer) ListLineages(ctx context.Context, params *api.ListLineagesParams) (*api.LineageListResponse, error) {
+	limit := lineageListDefaultLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
 
-	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
-		shouldRetry, err = p.tryReleaseTicket(ctx, lineageId, ticketExtId)
-		if err != nil {
-			if shouldRetry {
-				log.Ctx(ctx).Info().
-					Str("lineageId", lineageId).
-					Str("extId", ticketExtId).
-					Msg("retrying to release ticket")
+	if limit < 1 {
+		return nil, ticket.ErrInvalidRequest
+	}
 
-				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
-			} else {
-				return err
-			}
+	// the first page has no cursor rather than an empty one, lineages may have an empty extId
+	var after *string
+	if params.Cursor != nil {
+		c, err := base64.RawURLEncoding.DecodeString(*params.Cursor)
+		if err != nil {
+			return nil, ticket.ErrInvalidRequest
 		}
+		a := string(c)
+		after = &a
 	}
 
-	return nil
-}
+	query := queryStringSelectLineages
+	args := []interface{}{ticket.NamespaceFromContext(ctx), after}
 
-func (p *Servicer) GetTickets(ctx context.Context, lineageId string, ticketExtIds []string) (*api.TicketLeaseResponse, error) {
-	var nonce int
-	var stateStr string
-	var extId string
+	if params.LabelSelector != nil {
+		var err error
+		query, args, err = withLabelSelector(ctx, query, args, string(*params.LabelSelector))
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	rows, err := p.db.QueryContext(ctx, queryStringSelectTickets, lineageId, pq.Array(ticketExtIds))
-	defer rowCloser(rows)
+	args = append(args, limit+1)
+	query += fmt.Sprintf(" order by ext_id limit $%d", len(args))
 
+	rows, err := p.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code {
-			// 22P02 INVALID TEXT REPRESENTATION
-			case "22P02":
-				return nil, ticket.ErrInvalidRequest
-			}
-		}
-
 		return nil, err
 	}
-	var tickets []api.TicketLease
+	defer rowClose(ctx, rows)
 
+	lineages := make([]api.LineageGetResponse, 0)
 	for rows.Next() {
-		if err := rows.Scan(&extId, &nonce, &stateStr); err != nil {
+		lineage, err := scanLineage(rows)
+		if err != nil {
 			return nil, err
 		}
 
-		ticketLease := api.TicketLease{
-			ExtId:     extId,
-			LineageId: lineageId,
-			Nonce:     nonce,
-			State:     api.TicketLeaseState(stateStr),
-		}
-
-		tickets = append(tickets, ticketLease)
+		lineages = append(lineages, *lineage)
 	}
-	if len(tickets) == 0 {
-		return nil, ticket.ErrNoSuchTicket
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	resp := &api.TicketLeaseResponse{
-		Leases: &tickets,
+	resp := &api.LineageListResponse{}
+	if len(lineages) > limit {
+		lineages = lineages[:limit]
+		nextCursor := base64.RawURLEncoding.EncodeToString([]byte(lineages[limit-1].ExtId))
+		resp.NextCursor = &nextCursor
 	}
+	resp.Lineages = lineages
 
 	log.Ctx(ctx).Info().
-		Str("lineageId", lineageId).
-		Strs("extIds", ticketExtIds).
-		Msg("retrieved tickets")
+		Int("count", len(lineages)).
+		Msg("listed lineages")
 
 	return resp, nil
 }
 
-func (p *Servicer) tryReleaseTicket(ctx context.Context, lineageId string, ticketExtId string) (bool, error) {
-	version, err := p.getLineageVersion(ctx, lineageId)
+func (p *Servicer) GetLineageStats(ctx context.Context, params *api.GetLineageStatsParams) (
+	*api.LineageStatsResponse, error) {
+
+	query := queryStringSelectLineageStats
+	args := []interface{}{ticket.NamespaceFromContext(ctx)}
+
+	if params.LabelSelector != nil {
+		var err error
+		query, args, err = withLabelSelector(ctx, query, args, string(*params.LabelSelector))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var resp api.LineageStatsResponse
+	err := p.db.QueryRowContext(ctx, query, args...).
+		Scan(&resp.LineageCount, &resp.LeasedNonceCount, &resp.ReleasedNonceCount, &resp.ClosedNonceCount,
+			&resp.MaxLeasedNonceCount)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Int("lineageCount", resp.LineageCount).
+		Msg("retrieved lineage stats")
+
+	return &resp, nil
+}
+
+func (p *Servicer) CloneLineage(ctx context.Context, lineageId string, request *api.LineageCloneRequest) (
+	*api.LineageGetResponse, error) {
+
+	namespace, ok := p.namespaces[ticket.NamespaceFromContext(ctx)]
+	if !ok {
+		return nil, ticket.ErrNoSuchNamespace
+	}
+
+	var maxLineageCount *int
+	if namespace.MaxLineageCount > 0 {
+		maxLineageCount = &namespace.MaxLineageCount
+	}
+
+	var labelsJson *string
+	if request.Labels != nil {
+		if err := ticket.ValidateLabels(*request.Labels); err != nil {
+			log.Ctx(ctx).Info().
+				Str("lineageId", lineageId).
+				Err(err).
+				Msg("can not clone lineage with invalid labels")
+
+			return nil, ticket.ErrInvalidRequest
+		}
+
+		b, err := json.Marshal(request.Labels)
+		if err != nil {
+			return nil, err
+		}
+		l := string(b)
+		labelsJson = &l
+	}
+
+	includeTickets := request.IncludeTickets != nil && *request.IncludeTickets
+
+	aUuid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.db.ExecContext(ctx, queryStringCloneLineage, lineageId, namespace.Name, aUuid.String(), request.ExtId,
+		labelsJson, includeTickets, maxLineageCount, ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx))
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return nil, ticket.ErrInvalidRequest
+			}
+
+			switch pqErr.Message {
+			case sqlErrMessageNoSuchLineage:
+				return nil, ticket.ErrNoSuchLineage
+			case sqlErrMessageNamespaceLimitExceeded:
+				log.Ctx(ctx).Info().
+					Str("namespace", namespace.Name).
+					Str("extId", request.ExtId).
+					Msg("can not clone lineage, namespace lineage count limit reached")
+
+				return nil, ticket.ErrNamespaceLimitExceeded
+			}
+		}
+
+		return nil, insertLineageError(err)
+	}
+
+	resp, err := scanLineage(p.db.QueryRowContext(ctx, queryStringSelectLineageById, aUuid.String(), namespace.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("sourceLineageId", lineageId).
+		Str("lineageId", resp.Id).
+		Str("extId", resp.ExtId).
+		Bool("includeTickets", includeTickets).
+		Msg("cloned lineage")
+
+	return resp, nil
+}
+
+func (p *Servicer) ListLineageEvents(ctx context.Context, lineageId string, params *api.ListLineageEventsParams) (
+	*api.LineageEventListResponse, error) {
+
+	limit := eventListDefaultLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var after int64
+	if params.After != nil {
+		after = *params.After
+	}
+
+	if limit < 1 || after < 0 {
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	if _, err := p.getLineageVersion(ctx, lineageId); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.db.QueryContext(ctx, queryStringSelectLineageEvents, lineageId, ticket.NamespaceFromContext(ctx),
+		after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rowClose(ctx, rows)
+
+	events := make([]api.LineageEvent, 0)
+	for rows.Next() {
+		event := api.LineageEvent{LineageId: lineageId}
+		var eventType string
+		var extId sql.NullString
+		var nonce sql.NullInt64
+		var actor sql.NullString
+
+		if err := rows.Scan(&event.Seq, &eventType, &extId, &nonce, &actor, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		event.Type = api.LineageEventType(eventType)
+		if extId.Valid {
+			event.ExtId = &extId.String
+		}
+		if nonce.Valid {
+			event.Nonce = &nonce.Int64
+		}
+		if actor.Valid {
+			event.Actor = &actor.String
+		}
+
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Int64("after", after).
+		Int("count", len(events)).
+		Msg("listed lineage events")
+
+	return &api.LineageEventListResponse{Events: events}, nil
+}
+
+// GetLineageEventSeq returns the sequence number of the latest event of the lineage, zero if it has none.
+func (p *Servicer) GetLineageEventSeq(ctx context.Context, lineageId string) (int64, error) {
+	var seq int64
+	err := p.db.QueryRowContext(ctx, queryStringSelectLineageEventSeq, lineageId, ticket.NamespaceFromContext(ctx)).
+		Scan(&seq)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ticket.ErrNoSuchLineage
+		}
+
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return 0, ticket.ErrInvalidRequest
+			}
+		}
+
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+func (p *Servicer) SubscribeLineageEvents(ctx context.Context, lineageId string) *ticket.EventSubscription {
+	return p.events.Subscribe(ticket.NamespaceFromContext(ctx), lineageId)
+}
+
+// GetLineagesEventCursor returns the cursor of the events of transactions which did not commit yet.
+func (p *Servicer) GetLineagesEventCursor(ctx context.Context) (ticket.EventCursor, error) {
+	horizon, err := p.getEventHorizon(ctx)
+	if err != nil {
+		return ticket.EventCursor{}, err
+	}
+
+	return ticket.EventCursor{TxId: horizon}, nil
+}
+
+// ListLineagesEvents returns the events after the cursor of every lineage matching the label selector and ext id
+// prefix. Only the events of transactions older than the oldest running one are returned, so no event can commit
+// behind the returned cursor.
+func (p *Servicer) ListLineagesEvents(ctx context.Context, params *api.StreamLineagesEventsParams,
+	after ticket.EventCursor, limit int) ([]ticket.CursoredLineageEvent, ticket.EventCursor, error) {
+
+	if limit < 1 || after.TxId < 0 || after.Id < 0 {
+		return nil, after, ticket.ErrInvalidRequest
+	}
+
+	// the horizon is taken before the events are read, every transaction below it has ended by then
+	horizon, err := p.getEventHorizon(ctx)
+	if err != nil {
+		return nil, after, err
+	}
+
+	query := queryStringSelectLineagesEvents
+	args := []interface{}{ticket.NamespaceFromContext(ctx), after.TxId, after.Id, horizon}
+
+	if params.ExtIdPrefix != nil && *params.ExtIdPrefix != "" {
+		args = append(args, *params.ExtIdPrefix)
+		query += fmt.Sprintf(" and starts_with(l.ext_id, $%d)", len(args))
+	}
+
+	if params.LabelSelector != nil {
+		query, args, err = withLabelSelector(ctx, query, args, string(*params.LabelSelector))
+		if err != nil {
+			return nil, after, err
+		}
+	}
+
+	args = append(args, limit)
+	query += fmt.Sprintf(" order by e.txid, e.id limit $%d", len(args))
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, after, err
+	}
+	defer rowClose(ctx, rows)
+
+	events := make([]ticket.CursoredLineageEvent, 0)
+	for rows.Next() {
+		var e ticket.CursoredLineageEvent
+		var eventType string
+		var extId sql.NullString
+		var nonce sql.NullInt64
+		var actor sql.NullString
+
+		if err := rows.Scan(&e.Cursor.TxId, &e.Cursor.Id, &e.Event.LineageId, &e.Event.Seq, &eventType, &extId,
+			&nonce, &actor, &e.Event.CreatedAt); err != nil {
+
+			return nil, after, err
+		}
+		e.Event.Type = api.LineageEventType(eventType)
+		if extId.Valid {
+			e.Event.ExtId = &extId.String
+		}
+		if nonce.Valid {
+			e.Event.Nonce = &nonce.Int64
+		}
+		if actor.Valid {
+			e.Event.Actor = &actor.String
+		}
+
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, after, err
+	}
+
+	// a short page holds every matching event below the horizon, the next read starts at the horizon so it does not
+	// scan the events of the other lineages again
+	next := after
+	if len(events) > 0 {
+		next = events[len(events)-1].Cursor
+	}
+	if horizonCursor := (ticket.EventCursor{TxId: horizon}); len(events) < limit && horizonCursor.After(next) {
+		next = horizonCursor
+	}
+
+	return events, next, nil
+}
+
+func (p *Servicer) getEventHorizon(ctx context.Context) (int64, error) {
+	var horizon int64
+	if err := p.db.QueryRowContext(ctx, queryStringSelectEventHorizon).Scan(&horizon); err != nil {
+		return 0, err
+	}
+
+	return horizon, nil
+}
+
+// LeaseTicket leases the tickets. If the context carries a wait, see ticket.WithWait, a lease exceeding the
+// maxLeasedNonceCount of the lineage waits in line for tickets of the lineage to be released or closed.
+func (p *Servicer) LeaseTicket(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) (*api.TicketLeaseResponse, error) {
+	timeout, ok := ticket.WaitFromContext(ctx)
+	if !ok {
+		return p.leaseTicket(ctx, lineageId, request)
+	}
+
+	var resp *api.TicketLeaseResponse
+	err := p.waiters.Wait(ctx, lineageId, timeout, func() error {
+		var err error
+		resp, err = p.leaseTicket(ctx, lineageId, request)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (p *Servicer) leaseTicket(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) (*api.TicketLeaseResponse, error) {
+	if request.Partial != nil && *request.Partial {
+		return p.leaseTicketsPartially(ctx, lineageId, request)
+	}
+
+	var err error
+	shouldRetry := true
+	var nonces []int64
+
+	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
+		nonces, shouldRetry, err = p.tryLeaseTicket(ctx, lineageId, request)
+		if err != nil {
+			if shouldRetry {
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Strs("extId", request.ExtIds).
+					Msg("retrying to lease ticket")
+
+				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
+			} else {
+				return nil, err
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var leases []api.TicketLease
+	for i, n := range nonces {
+		l := api.TicketLease{
+			LineageId: lineageId,
+			Nonce:     int(n), //TODO - check correct cast
+			ExtId:     request.ExtIds[i],
+			State:     api.TicketLeaseStateLeased,
+		}
+
+		leases = append(leases, l)
+	}
+
+	resp := &api.TicketLeaseResponse{
+		Leases: &leases,
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Strs("extId", request.ExtIds).
+		Ints64("nonce", nonces).
+		Msg("leased tickets")
+
+	return resp, nil
+}
+
+func (p *Servicer) leaseTicketsPartially(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) (
+	*api.TicketLeaseResponse, error) {
+
+	if request.Contiguous != nil && *request.Contiguous {
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	var resp *api.TicketLeaseResponse
+	var err error
+	shouldRetry := true
+
+	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
+		resp, shouldRetry, err = p.tryLeaseTicketsPartially(ctx, lineageId, request)
+		if err != nil {
+			if shouldRetry {
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Strs("extId", request.ExtIds).
+					Msg("retrying to lease tickets partially")
+
+				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
+			} else {
+				return nil, err
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// tryLeaseTicketsPartially leases the tickets which fit into the free capacity of the lineage in request order.
+// The capacity is computed from the lineage at the version the tickets are created at, so a concurrent change
+// fails the optimistic lock instead of overbooking the lineage.
+func (p *Servicer) tryLeaseTicketsPartially(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) (
+	*api.TicketLeaseResponse, bool, error) {
+
+	var version int64
+	var leasedNonceCount, releasedNonceCount, maxLeasedNonceCount int
+
+	err := p.db.QueryRowContext(ctx, queryStringSelectLineageCapacity, lineageId, ticket.NamespaceFromContext(ctx)).
+		Scan(&version, &leasedNonceCount, &releasedNonceCount, &maxLeasedNonceCount)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, ticket.ErrNoSuchLineage
+		}
+
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return nil, false, ticket.ErrInvalidRequest
+			}
+		}
+
+		return nil, false, err
+	}
+
+	if expected, ok := ticket.ExpectedVersionFromContext(ctx); ok && expected != version {
+		return nil, false, ticket.ErrLineageVersionMismatch
+	}
+
+	rows, err := p.db.QueryContext(ctx, queryStringSelectTickets, lineageId, ticket.NamespaceFromContext(ctx),
+		pq.Array(request.ExtIds))
+	if err != nil {
+		return nil, false, err
+	}
+	defer rowClose(ctx, rows)
+
+	states := make(map[string]string)
+	for rows.Next() {
+		var extId, stateStr string
+		var nonce int
+		if err := rows.Scan(&extId, &nonce, &stateStr); err != nil {
+			return nil, false, err
+		}
+		states[extId] = stateStr
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	// released nonces are reused first and do not count against the limit again
+	capacity := maxLeasedNonceCount - leasedNonceCount + releasedNonceCount
+
+	var accepted []string
+	rejected := make([]api.TicketLeaseRejection, 0)
+	for _, extId := range request.ExtIds {
+		state, exists := states[extId]
+		switch {
+		case exists && state != string(api.TicketLeaseStateLeased):
+			rejected = append(rejected, api.TicketLeaseRejection{
+				ExtId: extId,
+				Code:  api.TicketLeaseRejectionCodeNotLeasable,
+			})
+		case exists:
+			accepted = append(accepted, extId)
+		case capacity > 0:
+			accepted = append(accepted, extId)
+			capacity--
+		default:
+			rejected = append(rejected, api.TicketLeaseRejection{
+				ExtId: extId,
+				Code:  api.TicketLeaseRejectionCodeTooManyLeasedTickets,
+			})
+		}
+	}
+
+	leases := make([]api.TicketLease, 0, len(accepted))
+	if len(accepted) > 0 {
+		nonces, shouldRetry, err := p.createTickets(ctx, lineageId, version, accepted, false)
+		if err != nil {
+			return nil, shouldRetry, err
+		}
+
+		for i, n := range nonces {
+			leases = append(leases, api.TicketLease{
+				LineageId: lineageId,
+				Nonce:     int(n),
+				ExtId:     accepted[i],
+				State:     api.TicketLeaseStateLeased,
+			})
+		}
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Strs("extId", accepted).
+		Int("rejectedCount", len(rejected)).
+		Msg("leased tickets partially")
+
+	return &api.TicketLeaseResponse{
+		Leases:   &leases,
+		Rejected: &rejected,
+	}, false, nil
+}
+
+func (p *Servicer) tryLeaseTicket(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) ([]int64, bool, error) {
+	version, err := p.getExpectedLineageVersion(ctx, lineageId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return p.createTickets(ctx, lineageId, version, request.ExtIds,
+		request.Contiguous != nil && *request.Contiguous)
+}
+
+func (p *Servicer) createTickets(ctx context.Context, lineageId string, version int64, extIds []string,
+	contiguous bool) ([]int64, bool, error) {
+
+	query := queryStringCreateTicket
+	if contiguous {
+		query = queryStringCreateContiguousTickets
+	}
+
+	rows, err := p.db.QueryContext(ctx, query, lineageId, version, pq.Array(extIds),
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx))
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				log.Ctx(ctx).Error().
+					Str("lineageId", lineageId).
+					Strs("extIds", extIds).
+					Str("pqErr Where", pqErr.Where).
+					Err(err).
+					Msg("")
+				return nil, false, ticket.ErrInvalidRequest
+			}
+
+			switch pqErr.Message {
+			case sqlErrMessageValidationError:
+				return nil, false, ticket.ErrInvalidRequest
+			case sqlErrMessageMaxUnusedLimitExceeded:
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Strs("extId", extIds).
+					Msg("can not lease ticket, too many leased tickets in lineage")
+
+				return nil, false, ticket.ErrTooManyLeasedTickets
+			case sqlErrMessageNotContiguous:
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Strs("extId", extIds).
+					Msg("can not lease ticket, no contiguous range of nonces available")
+
+				return nil, false, ticket.ErrNoContiguousRange
+			case sqlErrMessageLineagePaused:
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Strs("extId", extIds).
+					Msg("can not lease ticket, lineage is paused")
+
+				return nil, false, ticket.ErrLineagePaused
+			case sqlErrMessageOptimisticLock:
+				log.Ctx(ctx).Debug().
+					Str("lineageId", lineageId).
+					Strs("extId", extIds).
+					Msg("can not lease ticket due to too many concurrent requests(optimistic lock)")
+
+				return nil, true, ticket.ErrTooManyConcurrentRequests
+			default:
+				log.Ctx(ctx).Error().
+					Err(err).
+					Msg("can not lease due to unhandled error")
+
+				return nil, false, err
+			}
+		}
+		return nil, false, err
+	}
+	defer rowClose(ctx, rows)
+
+	nonces, err := getNoncesFromRow(rows)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return nonces, false, nil
+}
+
+func (p *Servicer) GetTicket(ctx context.Context, lineageId string, ticketExtId string) (*api.TicketLeaseResponse, error) {
+	var nonce int
+	var stateStr string
+
+	row := p.db.QueryRowContext(ctx, queryStringSelectTicket, lineageId, ticket.NamespaceFromContext(ctx), ticketExtId)
+
+	if err := row.Err(); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return nil, ticket.ErrInvalidRequest
+			}
+		}
+
+		return nil, err
+	}
+
+	if err := row.Scan(&nonce, &stateStr); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ticket.ErrNoSuchTicket
+		}
+
+		return nil, err
+	}
+
+	resp := &api.TicketLeaseResponse{
+		Leases: &[]api.TicketLease{
+			{
+				ExtId:     ticketExtId,
+				LineageId: lineageId,
+				Nonce:     nonce,
+				State:     api.TicketLeaseState(stateStr),
+			},
+		},
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Str("extId", ticketExtId).
+		Msg("retrieved ticket")
+
+	return resp, nil
+}
+
+func (p *Servicer) ReleaseTicket(ctx context.Context, lineageId string, ticketExtId string) error {
+	var err error
+	shouldRetry := true
+
+	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
+		shouldRetry, err = p.tryReleaseTicket(ctx, lineageId, ticketExtId)
+		if err != nil {
+			if shouldRetry {
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Str("extId", ticketExtId).
+					Msg("retrying to release ticket")
+
+				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
+			} else {
+				return err
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	p.waiters.Notify(lineageId)
+
+	return nil
+}
+
+func (p *Servicer) GetTickets(ctx context.Context, lineageId string, ticketExtIds []string, allOrNothing bool) (
+	*api.TicketLeaseResponse, error) {
+
+	var nonce int
+	var stateStr string
+	var extId string
+
+	if _, err := p.getLineageVersion(ctx, lineageId); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.db.QueryContext(ctx, queryStringSelectTickets, lineageId, ticket.NamespaceFromContext(ctx),
+		pq.Array(ticketExtIds))
+	defer rowCloser(rows)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return nil, ticket.ErrInvalidRequest
+			}
+		}
+
+		return nil, err
+	}
+	tickets := make([]api.TicketLease, 0)
+	found := make(map[string]bool)
+
+	for rows.Next() {
+		if err := rows.Scan(&extId, &nonce, &stateStr); err != nil {
+			return nil, err
+		}
+		found[extId] = true
+
+		ticketLease := api.TicketLease{
+			ExtId:     extId,
+			LineageId: lineageId,
+			Nonce:     nonce,
+			State:     api.TicketLeaseState(stateStr),
+		}
+
+		tickets = append(tickets, ticketLease)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	missingExtIds := make([]string, 0)
+	for _, id := range ticketExtIds {
+		if !found[id] {
+			missingExtIds = append(missingExtIds, id)
+			// report duplicated ext ids only once
+			found[id] = true
+		}
+	}
+
+	if allOrNothing && len(missingExtIds) > 0 {
+		log.Ctx(ctx).Info().
+			Str("lineageId", lineageId).
+			Strs("missingExtIds", missingExtIds).
+			Msg("not all tickets found")
+
+		return nil, ticket.ErrNoSuchTicket
+	}
+
+	resp := &api.TicketLeaseResponse{
+		Leases:        &tickets,
+		MissingExtIds: &missingExtIds,
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Strs("extIds", ticketExtIds).
+		Strs("missingExtIds", missingExtIds).
+		Msg("retrieved tickets")
+
+	return resp, nil
+}
+
+func (p *Servicer) tryReleaseTicket(ctx context.Context, lineageId string, ticketExtId string) (bool, error) {
+	version, err := p.getExpectedLineageVersion(ctx, lineageId)
 	if err != nil {
 		return false, err
 	}
 
-	rows, err := p.db.QueryContext(ctx, queryStringReleaseTicket, lineageId, version, ticketExtId)
+	rows, err := p.db.QueryContext(ctx, queryStringReleaseTicket, lineageId, version, ticketExtId,
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx))
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -418,56 +1307,254 @@ func (p *Servicer) tryReleaseTicket(ctx context.Context, lineageId string, ticke
 	}
 	defer rowClose(ctx, rows)
 
-	nonce, err := getNonceFromRow(rows)
-	if err != nil {
-		return false, err
+	nonce, err := getNonceFromRow(rows)
+	if err != nil {
+		return false, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Str("extId", ticketExtId).
+		Int("nonce", *nonce).
+		Msg("released ticket")
+
+	return false, nil
+}
+
+func (p *Servicer) CloseTicket(ctx context.Context, lineageId string, ticketExtId string) error {
+	var err error
+	shouldRetry := true
+
+	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
+		shouldRetry, err = p.tryCloseTicket(ctx, lineageId, ticketExtId)
+		if err != nil {
+			if shouldRetry {
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Str("extId", ticketExtId).
+					Msg("retrying to close ticket")
+
+				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
+			} else {
+				return err
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	p.waiters.Notify(lineageId)
+
+	return nil
+}
+
+func (p *Servicer) tryCloseTicket(ctx context.Context, lineageId string, ticketExtId string) (bool, error) {
+	version, err := p.getExpectedLineageVersion(ctx, lineageId)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = p.db.ExecContext(ctx, queryStringCloseTicket, lineageId, version, ticketExtId,
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx))
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return false, ticket.ErrInvalidRequest
+			}
+
+			switch pqErr.Message {
+			case sqlErrMessageNoSuchTicket:
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Str("extId", ticketExtId).
+					Msg("ticket not found")
+
+				return false, ticket.ErrNoSuchTicket
+			case sqlErrMessageAlreadyClosed:
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Str("extId", ticketExtId).
+					Msg("not closing ticket, was already closed")
+
+				return false, nil
+			case sqlErrMessageOptimisticLock:
+				log.Ctx(ctx).Debug().
+					Str("lineageId", lineageId).
+					Str("extId", ticketExtId).
+					Msg("can not close due to too many concurrent requests(optimistic lock)")
+
+				return true, ticket.ErrTooManyConcurrentRequests
+			default:
+				log.Ctx(ctx).Error().
+					Err(err).
+					Msg("can not close due to unhandled error")
+
+				return false, err
+			}
+		}
+		return false, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Str("extId", ticketExtId).
+		Msg("closed ticket")
+	return false, nil
+}
+
+func (p *Servicer) UpdateTickets(ctx context.Context, lineageId string, request *api.TicketBulkUpdateRequest) (
+	*api.TicketBulkUpdateResponse, error) {
+
+	var resp *api.TicketBulkUpdateResponse
+	var err error
+	shouldRetry := true
+
+	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
+		resp, shouldRetry, err = p.tryUpdateTickets(ctx, lineageId, request)
+		if err != nil {
+			if shouldRetry {
+				log.Ctx(ctx).Info().
+					Str("lineageId", lineageId).
+					Msg("retrying to update tickets")
+
+				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
+			} else {
+				return nil, err
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.waiters.Notify(lineageId)
+
+	return resp, nil
+}
+
+func (p *Servicer) tryUpdateTickets(ctx context.Context, lineageId string, request *api.TicketBulkUpdateRequest) (
+	*api.TicketBulkUpdateResponse, bool, error) {
+
+	version, err := p.getExpectedLineageVersion(ctx, lineageId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	extIds := make([]string, len(request.Tickets))
+	states := make([]string, len(request.Tickets))
+	for i, t := range request.Tickets {
+		extIds[i] = t.ExtId
+		states[i] = string(t.State)
+	}
+
+	var outcomes []string
+	err = p.db.QueryRowContext(ctx, queryStringUpdateTickets, lineageId, version, pq.Array(extIds), pq.Array(states),
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx)).
+		Scan(pq.Array(&outcomes))
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return nil, false, ticket.ErrInvalidRequest
+			}
+
+			switch pqErr.Message {
+			case sqlErrMessageValidationError:
+				return nil, false, ticket.ErrInvalidRequest
+			case sqlErrMessageOptimisticLock:
+				log.Ctx(ctx).Debug().
+					Str("lineageId", lineageId).
+					Msg("can not update tickets due to too many concurrent requests(optimistic lock)")
+
+				return nil, true, ticket.ErrTooManyConcurrentRequests
+			default:
+				log.Ctx(ctx).Error().
+					Err(err).
+					Msg("can not update tickets due to unhandled error")
+
+				return nil, false, err
+			}
+		}
+		return nil, false, err
+	}
+
+	resp := &api.TicketBulkUpdateResponse{
+		Results: make([]api.TicketBulkUpdateResult, len(outcomes)),
+	}
+	for i, outcome := range outcomes {
+		resp.Results[i] = api.TicketBulkUpdateResult{
+			ExtId:   extIds[i],
+			Outcome: api.TicketBulkUpdateResultOutcome(outcome),
+		}
 	}
 
 	log.Ctx(ctx).Info().
 		Str("lineageId", lineageId).
-		Str("extId", ticketExtId).
-		Int("nonce", *nonce).
-		Msg("released ticket")
+		Int("count", len(outcomes)).
+		Msg("updated tickets")
 
-	return false, nil
+	return resp, false, nil
 }
 
-func (p *Servicer) CloseTicket(ctx context.Context, lineageId string, ticketExtId string) error {
+func (p *Servicer) TransferTicket(ctx context.Context, lineageId string, ticketExtId string,
+	request *api.TicketTransferRequest) (*api.TicketLeaseResponse, error) {
+
+	var nonce int64
 	var err error
 	shouldRetry := true
 
 	for attempt := 1; shouldRetry && attempt <= optimisticLockMaxRetryAttempts; attempt++ {
-		shouldRetry, err = p.tryCloseTicket(ctx, lineageId, ticketExtId)
+		nonce, shouldRetry, err = p.tryTransferTicket(ctx, lineageId, ticketExtId, request.ExtId)
 		if err != nil {
 			if shouldRetry {
 				log.Ctx(ctx).Info().
 					Str("lineageId", lineageId).
 					Str("extId", ticketExtId).
-					Msg("retrying to close ticket")
+					Msg("retrying to transfer ticket")
 
 				jitterSleep(attempt, optimisticLockSleepBase, optimisticLockSleepMax)
 			} else {
-				return err
+				return nil, err
 			}
 		}
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return nil
+	return &api.TicketLeaseResponse{
+		Leases: &[]api.TicketLease{
+			{
+				ExtId:     request.ExtId,
+				LineageId: lineageId,
+				Nonce:     int(nonce),
+				State:     api.TicketLeaseStateLeased,
+			},
+		},
+	}, nil
 }
 
-func (p *Servicer) tryCloseTicket(ctx context.Context, lineageId string, ticketExtId string) (bool, error) {
-	version, err := p.getLineageVersion(ctx, lineageId)
+func (p *Servicer) tryTransferTicket(ctx context.Context, lineageId string, ticketExtId string,
+	newTicketExtId string) (int64, bool, error) {
+
+	version, err := p.getExpectedLineageVersion(ctx, lineageId)
 	if err != nil {
-		return false, err
+		return 0, false, err
 	}
 
-	_, err = p.db.ExecContext(ctx, queryStringCloseTicket, lineageId, version, ticketExtId)
+	var nonce int64
+	err = p.db.QueryRowContext(ctx, queryStringTransferTicket, lineageId, version, ticketExtId, newTicketExtId,
+		ticket.ActorFromContext(ctx), ticket.PrincipalFromContext(ctx)).Scan(&nonce)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
 			// 22P02 INVALID TEXT REPRESENTATION
 			case "22P02":
-				return false, ticket.ErrInvalidRequest
+				return 0, false, ticket.ErrInvalidRequest
 			}
 
 			switch pqErr.Message {
@@ -477,41 +1564,399 @@ func (p *Servicer) tryCloseTicket(ctx context.Context, lineageId string, ticketE
 					Str("extId", ticketExtId).
 					Msg("ticket not found")
 
-				return false, ticket.ErrNoSuchTicket
-			case sqlErrMessageAlreadyClosed:
+				return 0, false, ticket.ErrNoSuchTicket
+			case sqlErrMessageValidationError:
 				log.Ctx(ctx).Info().
 					Str("lineageId", lineageId).
 					Str("extId", ticketExtId).
-					Msg("not closing ticket, was already closed")
+					Str("newExtId", newTicketExtId).
+					Msg("can not transfer ticket, it is not leased or the new ext id is taken")
 
-				return false, nil
+				return 0, false, ticket.ErrInvalidRequest
 			case sqlErrMessageOptimisticLock:
 				log.Ctx(ctx).Debug().
 					Str("lineageId", lineageId).
 					Str("extId", ticketExtId).
-					Msg("can not close due to too many concurrent requests(optimistic lock)")
+					Msg("can not transfer due to too many concurrent requests(optimistic lock)")
 
-				return true, ticket.ErrTooManyConcurrentRequests
+				return 0, true, ticket.ErrTooManyConcurrentRequests
 			default:
 				log.Ctx(ctx).Error().
 					Err(err).
-					Msg("can not close due to unhandled error")
+					Msg("can not transfer due to unhandled error")
 
-				return false, err
+				return 0, false, err
 			}
 		}
-		return false, err
+		return 0, false, err
 	}
 
 	log.Ctx(ctx).Info().
 		Str("lineageId", lineageId).
 		Str("extId", ticketExtId).
-		Msg("closed ticket")
-	return false, nil
+		Str("newExtId", newTicketExtId).
+		Int64("nonce", nonce).
+		Msg("transferred ticket")
+
+	return nonce, false, nil
+}
+
+func (p *Servicer) ListTickets(ctx context.Context, lineageId string, params *api.GetTicketsParams) (
+	*api.TicketLeaseResponse, error) {
+
+	limit := ticketListDefaultLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	if limit < 1 {
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	if _, err := p.getLineageVersion(ctx, lineageId); err != nil {
+		return nil, err
+	}
+
+	query := queryStringSelectTicketPage
+	args := []interface{}{lineageId, ticket.NamespaceFromContext(ctx)}
+
+	if params.Cursor != nil {
+		leasedAt, nonce, extId, err := decodeTicketCursor(*params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, leasedAt, nonce, extId)
+		query += fmt.Sprintf(" and (t.leased_at, t.nonce, t.ext_id) > ($%d, $%d, $%d)", len(args)-2, len(args)-1,
+			len(args))
+	}
+
+	if params.State != nil {
+		args = append(args, string(*params.State))
+		query += fmt.Sprintf(" and t.lease_status = $%d", len(args))
+	}
+
+	if params.LeasedAfter != nil {
+		args = append(args, *params.LeasedAfter)
+		query += fmt.Sprintf(" and t.leased_at >= $%d", len(args))
+	}
+
+	if params.LeasedBefore != nil {
+		args = append(args, *params.LeasedBefore)
+		query += fmt.Sprintf(" and t.leased_at < $%d", len(args))
+	}
+
+	if params.MinNonce != nil {
+		args = append(args, *params.MinNonce)
+		query += fmt.Sprintf(" and t.nonce >= $%d", len(args))
+	}
+
+	if params.MaxNonce != nil {
+		args = append(args, *params.MaxNonce)
+		query += fmt.Sprintf(" and t.nonce <= $%d", len(args))
+	}
+
+	args = append(args, limit+1)
+	query += fmt.Sprintf(" order by t.leased_at, t.nonce, t.ext_id limit $%d", len(args))
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rowClose(ctx, rows)
+
+	tickets := make([]api.TicketLease, 0)
+	for rows.Next() {
+		var stateStr string
+		var leasedAt time.Time
+		ticketLease := api.TicketLease{LineageId: lineageId}
+
+		if err := rows.Scan(&ticketLease.ExtId, &ticketLease.Nonce, &stateStr, &leasedAt); err != nil {
+			return nil, err
+		}
+		ticketLease.State = api.TicketLeaseState(stateStr)
+		ticketLease.LeasedAt = &leasedAt
+
+		tickets = append(tickets, ticketLease)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	resp := &api.TicketLeaseResponse{}
+	if len(tickets) > limit {
+		tickets = tickets[:limit]
+		last := tickets[limit-1]
+		nextCursor := encodeTicketCursor(*last.LeasedAt, int64(last.Nonce), last.ExtId)
+		resp.NextCursor = &nextCursor
+	}
+	resp.Leases = &tickets
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Int("count", len(tickets)).
+		Msg("listed tickets")
+
+	return resp, nil
+}
+
+func (p *Servicer) GetNonce(ctx context.Context, lineageId string, nonce int64) (*api.NonceGetResponse, error) {
+	var nextNonce int64
+	var extId sql.NullString
+	var stateStr sql.NullString
+	var leasedAt sql.NullTime
+	var released bool
+
+	err := p.db.QueryRowContext(ctx, queryStringSelectNonce, lineageId, ticket.NamespaceFromContext(ctx), nonce).
+		Scan(&nextNonce, &extId, &stateStr, &leasedAt, &released)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ticket.ErrNoSuchLineage
+		}
+
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return nil, ticket.ErrInvalidRequest
+			}
+		}
+
+		return nil, err
+	}
+
+	resp := &api.NonceGetResponse{
+		LineageId: lineageId,
+		Nonce:     nonce,
+	}
+
+	switch {
+	case extId.Valid:
+		resp.Status = api.NonceGetResponseStatus(stateStr.String)
+		resp.ExtId = &extId.String
+		if leasedAt.Valid {
+			resp.LeasedAt = &leasedAt.Time
+		}
+	case released:
+		resp.Status = api.NonceGetResponseStatusReleased
+	case nonce < nextNonce:
+		resp.Status = api.NonceGetResponseStatusUnused
+	default:
+		resp.Status = api.NonceGetResponseStatusNeverIssued
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Int64("nonce", nonce).
+		Str("status", string(resp.Status)).
+		Msg("retrieved nonce")
+
+	return resp, nil
+}
+
+func (p *Servicer) GetTicketHistory(ctx context.Context, lineageId string, ticketExtId string) (
+	*api.TicketHistoryResponse, error) {
+
+	if _, err := p.getLineageVersion(ctx, lineageId); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.db.QueryContext(ctx, queryStringSelectTicketHistory, lineageId, ticket.NamespaceFromContext(ctx),
+		ticketExtId)
+	if err != nil {
+		return nil, err
+	}
+	defer rowClose(ctx, rows)
+
+	entries := make([]api.TicketHistoryEntry, 0)
+	for rows.Next() {
+		var entry api.TicketHistoryEntry
+		var action string
+		var actor sql.NullString
+		var principal sql.NullString
+
+		if err := rows.Scan(&entry.Nonce, &action, &actor, &principal, &entry.Timestamp); err != nil {
+			return nil, err
+		}
+		entry.Action = api.TicketHistoryEntryAction(action)
+		if actor.Valid {
+			entry.Actor = &actor.String
+		}
+		if principal.Valid {
+			entry.Principal = &principal.String
+		}
+
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, ticket.ErrNoSuchTicket
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Str("extId", ticketExtId).
+		Int("count", len(entries)).
+		Msg("retrieved ticket history")
+
+	return &api.TicketHistoryResponse{
+		LineageId: lineageId,
+		ExtId:     ticketExtId,
+		Entries:   entries,
+	}, nil
+}
+
+func (p *Servicer) ReserveIdempotencyKey(ctx context.Context, lineageId string, key string, fingerprint string) (
+	*ticket.IdempotentResponse, error) {
+
+	if _, err := p.getLineageVersion(ctx, lineageId); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.db.ExecContext(ctx, queryStringDeleteExpiredIdempotencyKeys, lineageId); err != nil {
+		return nil, err
+	}
+
+	rows, err := p.db.QueryContext(ctx, queryStringReserveIdempotencyKey, lineageId, key, fingerprint,
+		ticket.IdempotencyKeyLockTimeout.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	reserved := rows.Next()
+	rowClose(ctx, rows)
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if reserved {
+		log.Ctx(ctx).Debug().
+			Str("lineageId", lineageId).
+			Str("idempotencyKey", key).
+			Msg("reserved idempotency key")
+
+		return nil, nil
+	}
+
+	var storedFingerprint string
+	var statusCode sql.NullInt64
+	var contentType sql.NullString
+	var body []byte
+
+	err = p.db.QueryRowContext(ctx, queryStringSelectIdempotencyKey, lineageId, key).
+		Scan(&storedFingerprint, &statusCode, &contentType, &body)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// the request holding the key failed and released it in the meantime
+			return nil, ticket.ErrIdempotencyKeyInProgress
+		}
+
+		return nil, err
+	}
+
+	if storedFingerprint != fingerprint {
+		log.Ctx(ctx).Info().
+			Str("lineageId", lineageId).
+			Str("idempotencyKey", key).
+			Msg("idempotency key reused for a different request")
+
+		return nil, ticket.ErrIdempotencyKeyReused
+	}
+
+	if !statusCode.Valid {
+		return nil, ticket.ErrIdempotencyKeyInProgress
+	}
+
+	log.Ctx(ctx).Info().
+		Str("lineageId", lineageId).
+		Str("idempotencyKey", key).
+		Msg("replaying idempotent response")
+
+	return &ticket.IdempotentResponse{
+		StatusCode:  int(statusCode.Int64),
+		ContentType: contentType.String,
+		Body:        body,
+	}, nil
+}
+
+func (p *Servicer) CompleteIdempotencyKey(ctx context.Context, lineageId string, key string,
+	resp *ticket.IdempotentResponse, ttl time.Duration) error {
+
+	_, err := p.db.ExecContext(ctx, queryStringCompleteIdempotencyKey, lineageId, key, resp.StatusCode,
+		resp.ContentType, resp.Body, ttl.Milliseconds())
+
+	return err
+}
+
+func (p *Servicer) ReleaseIdempotencyKey(ctx context.Context, lineageId string, key string) error {
+	_, err := p.db.ExecContext(ctx, queryStringReleaseIdempotencyKey, lineageId, key)
+
+	return err
+}
+
+// encodeTicketCursor encodes the position of the last listed ticket, tickets are listed by lease time, nonce and
+// extId. A transferred ticket and its replacement share the lease time and the nonce, so only the extId tells them
+// apart.
+func encodeTicketCursor(leasedAt time.Time, nonce int64, extId string) string {
+	c := fmt.Sprintf("%s/%d/%s", leasedAt.UTC().Format(time.RFC3339Nano), nonce, extId)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(c))
+}
+
+func decodeTicketCursor(cursor string) (time.Time, int64, string, error) {
+	c, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, 0, "", ticket.ErrInvalidRequest
+	}
+
+	parts := strings.SplitN(string(c), "/", 3)
+	if len(parts) != 3 {
+		return time.Time{}, 0, "", ticket.ErrInvalidRequest
+	}
+
+	leasedAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, 0, "", ticket.ErrInvalidRequest
+	}
+
+	nonce, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return time.Time{}, 0, "", ticket.ErrInvalidRequest
+	}
+
+	return leasedAt, nonce, parts[2], nil
+}
+
+func (p *Servicer) GetLineageVersion(ctx context.Context, lineageId string) (int64, error) {
+	return p.getLineageVersion(ctx, lineageId)
+}
+
+// getExpectedLineageVersion returns the current version of the lineage, failing if the caller expects another one.
+// Once the version changes under a conditional request its retries fail here instead of being applied.
+func (p *Servicer) getExpectedLineageVersion(ctx context.Context, lineageId string) (int64, error) {
+	version, err := p.getLineageVersion(ctx, lineageId)
+	if err != nil {
+		return 0, err
+	}
+
+	if expected, ok := ticket.ExpectedVersionFromContext(ctx); ok && expected != version {
+		log.Ctx(ctx).Info().
+			Str("lineageId", lineageId).
+			Int64("version", version).
+			Int64("expectedVersion", expected).
+			Msg("lineage version does not match the expected version")
+
+		return 0, ticket.ErrLineageVersionMismatch
+	}
+
+	return version, nil
 }
 
 func (p *Servicer) getLineageVersion(ctx context.Context, lineageId string) (int64, error) {
-	rows, err := p.db.QueryContext(ctx, queryStringSelectLineageVersion, lineageId)
+	rows, err := p.db.QueryContext(ctx, queryStringSelectLineageVersion, lineageId,
+		ticket.NamespaceFromContext(ctx))
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -536,6 +1981,96 @@ func (p *Servicer) getLineageVersion(ctx context.Context, lineageId string) (int
 	return v, nil
 }
 
+// withLabelSelector appends the conditions of a label selector to a query that already has a where clause.
+func withLabelSelector(ctx context.Context, query string, args []interface{}, selector string) (
+	string, []interface{}, error) {
+
+	requirements, err := ticket.ParseLabelSelector(selector)
+	if err != nil {
+		log.Ctx(ctx).Info().
+			Str("labelSelector", selector).
+			Err(err).
+			Msg("invalid label selector")
+
+		return "", nil, ticket.ErrInvalidRequest
+	}
+
+	for _, r := range requirements {
+		switch r.Operator {
+		case ticket.LabelOperatorEquals:
+			args = append(args, r.Key, r.Value)
+			query += fmt.Sprintf(" and labels @> jsonb_build_object($%d::text, $%d::text)", len(args)-1, len(args))
+		case ticket.LabelOperatorNotEquals:
+			args = append(args, r.Key, r.Value)
+			query += fmt.Sprintf(" and not labels @> jsonb_build_object($%d::text, $%d::text)", len(args)-1, len(args))
+		case ticket.LabelOperatorExists:
+			args = append(args, r.Key)
+			query += fmt.Sprintf(" and labels ? $%d", len(args))
+		case ticket.LabelOperatorDoesNotExist:
+			args = append(args, r.Key)
+			query += fmt.Sprintf(" and not labels ? $%d", len(args))
+		}
+	}
+
+	return query, args, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanLineage(row rowScanner) (*api.LineageGetResponse, error) {
+	var resp api.LineageGetResponse
+	var createdAt sql.NullTime
+	var labels []byte
+	var startLeasingFrom sql.NullInt64
+	var chainId sql.NullInt64
+	var address sql.NullString
+
+	err := row.Scan(&resp.Id, &resp.ExtId, &resp.NextNonce, &resp.LeasedNonceCount, &resp.ReleasedNonceCount,
+		&resp.ClosedNonceCount, &resp.MaxLeasedNonceCount, &resp.MaxNonceValue, &resp.Version, &createdAt, &labels,
+		&resp.Namespace, &startLeasingFrom, &chainId, &address)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ticket.ErrNoSuchLineage
+		}
+
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			// 22P02 INVALID TEXT REPRESENTATION
+			case "22P02":
+				return nil, ticket.ErrInvalidRequest
+			}
+		}
+
+		return nil, err
+	}
+
+	if createdAt.Valid {
+		resp.CreatedAt = &createdAt.Time
+	}
+
+	if startLeasingFrom.Valid {
+		s := int(startLeasingFrom.Int64)
+		resp.StartLeasingFrom = &s
+	}
+
+	if chainId.Valid {
+		resp.ChainId = &chainId.Int64
+	}
+
+	if address.Valid {
+		resp.Address = &address.String
+	}
+
+	resp.Labels = api.Labels{}
+	if err := json.Unmarshal(labels, &resp.Labels); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
 func getNonceFromRow(rows *sql.Rows) (*int, error) {
 	var nonce int
 	if !rows.Next() {
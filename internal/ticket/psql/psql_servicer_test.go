@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"github.com/welthee/dinonce/v2/internal/ticket"
 	"github.com/welthee/dinonce/v2/internal/ticket/psql"
+	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
@@ -17,6 +21,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/webhook"
+	webhookpsql "github.com/welthee/dinonce/v2/internal/webhook/psql"
 )
 
 const (
@@ -29,7 +35,18 @@ const (
 
 const maxLeasedNonceCount = 64
 
+const (
+	namespaceTenant           = "tenant"
+	namespaceLimitedTenant    = "limited-tenant"
+	namespaceConcurrentTenant = "concurrent-tenant"
+)
+
+const concurrentTenantMaxLineageCount = 3
+
 var victim ticket.Servicer
+var testDb *sql.DB
+var adminVictim ticket.Administrator
+var webhookVictim webhook.Store
 var ctx = context.Background()
 
 func init() {
@@ -44,6 +61,7 @@ func init() {
 	if err != nil {
 		log.Fatal().Err(err).Msg("can not open db connection")
 	}
+	testDb = db
 
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
@@ -63,7 +81,19 @@ func init() {
 		log.Fatal().Err(err).Msg("failed to run migrations")
 	}
 
-	victim = psql.NewServicer(db)
+	events := ticket.NewEventSubscriptions()
+	go func() {
+		if err := psql.ListenLineageEvents(ctx, psqlInfo, db, events); err != nil {
+			log.Fatal().Err(err).Msg("can not listen for lineage events")
+		}
+	}()
+
+	victim = psql.NewServicer(db, events,
+		ticket.Namespace{Name: namespaceTenant},
+		ticket.Namespace{Name: namespaceLimitedTenant, MaxLineageCount: 1, MaxLeasedNonceCount: maxLeasedNonceCount},
+		ticket.Namespace{Name: namespaceConcurrentTenant, MaxLineageCount: concurrentTenantMaxLineageCount})
+	adminVictim = psql.NewAdministrator(db)
+	webhookVictim = webhookpsql.NewStore(db)
 }
 
 func TestServicer_CreateLineage(t *testing.T) {
@@ -75,7 +105,30 @@ func TestServicer_CreateLineage(t *testing.T) {
 	}
 }
 
-func TestServicer_CreateLineage_DuplicateExtIdFails(t *testing.T) {
+func TestServicer_CreateLineage_Idempotency(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+
+	req := &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID.String()),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+	}
+
+	first, err := victim.CreateLineage(ctx, req)
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	second, err := victim.CreateLineage(ctx, req)
+	if err != nil {
+		t.Fatalf("second lineage creation with same extId and configuration should succeed %s", err)
+	}
+
+	if first.Id != second.Id {
+		t.Errorf("expected existing lineage %s to be returned, got %s", first.Id, second.Id)
+	}
+}
+
+func TestServicer_CreateLineage_ConflictingConfigurationFails(t *testing.T) {
 	extIdUUID, _ := uuid.NewUUID()
 
 	req := &api.LineageCreationRequest{
@@ -88,12 +141,18 @@ func TestServicer_CreateLineage_DuplicateExtIdFails(t *testing.T) {
 		t.Errorf("can not create lineage %s", err)
 	}
 
+	req.MaxLeasedNonceCount = maxLeasedNonceCount / 2
 	_, err = victim.CreateLineage(ctx, req)
-	if err == nil {
-		t.Errorf("second lineage creation with same extId should fail")
+	if err == nil || err != ticket.ErrLineageConflict {
+		t.Errorf("expected ErrLineageConflict, got %s", err)
 	}
-	if err != ticket.ErrInvalidRequest {
-		t.Errorf("expected error to be of type invalid request")
+
+	startLeasingFrom := 10
+	req.MaxLeasedNonceCount = maxLeasedNonceCount
+	req.StartLeasingFrom = &startLeasingFrom
+	_, err = victim.CreateLineage(ctx, req)
+	if err == nil || err != ticket.ErrLineageConflict {
+		t.Errorf("expected ErrLineageConflict, got %s", err)
 	}
 }
 
@@ -131,307 +190,1417 @@ func TestServicer_GetLineage_NoSuchLineageError(t *testing.T) {
 	}
 }
 
-func TestServicer_LeaseTicket(t *testing.T) {
+func TestServicer_GetLineageById(t *testing.T) {
 	lineageId := createLineage(t)
 
 	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"tx1"},
+		ExtIds: []string{"tx1", "tx2", "tx3"},
 	}
 
-	resp, err := victim.LeaseTicket(ctx, lineageId, request)
-	if err != nil {
-		t.Errorf("can not lease ticket %s", err)
+	if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
+		t.Errorf("can not lease tickets %s", err)
 	}
 
-	nonce := ensureAndGetSingleNonce(t, resp)
-
-	if nonce != 0 {
-		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Errorf("can not release ticket %s", err)
 	}
-}
 
-func TestServicer_LeaseTicket_NoSuchLineage(t *testing.T) {
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"tx1"},
+	if err := victim.CloseTicket(ctx, lineageId, "tx3"); err != nil {
+		t.Errorf("can not close ticket %s", err)
 	}
 
-	lineageId, _ := uuid.NewUUID()
-
-	_, err := victim.LeaseTicket(ctx, lineageId.String(), request)
-	if err == nil || err != ticket.ErrNoSuchLineage {
-		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	resp, err := victim.GetLineageById(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not retrieve lineage %s", err)
 	}
-}
-
-func TestServicer_LeaseTicket_WithSameNonce(t *testing.T) {
-	lineageId := createLineage(t)
 
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"tx1"},
+	if resp.Id != lineageId {
+		t.Errorf("expected lineage id %s, got %s", lineageId, resp.Id)
 	}
 
-	resp, err := victim.LeaseTicket(ctx, lineageId, request)
-	if err != nil {
-		t.Errorf("can not lease ticket %s", err)
+	if resp.NextNonce != 3 {
+		t.Errorf("expected nextNonce to be 3, got %d", resp.NextNonce)
 	}
 
-	nonce := ensureAndGetSingleNonce(t, resp)
-
-	if nonce != 0 {
-		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	if resp.LeasedNonceCount != 2 {
+		t.Errorf("expected leasedNonceCount to be 2, got %d", resp.LeasedNonceCount)
 	}
 
-	_, err = victim.LeaseTicket(ctx, lineageId, request)
-	if err != nil {
-		t.Errorf("fail on multiple lease operations, should be idempotent %s", err)
+	if resp.ReleasedNonceCount != 1 {
+		t.Errorf("expected releasedNonceCount to be 1, got %d", resp.ReleasedNonceCount)
 	}
 
-	if nonce != 0 {
-		t.Errorf("expected second ticket creation request to have no side effects and reuse initially "+
-			"assigned nonce 0, got %d", nonce)
+	if resp.ClosedNonceCount != 1 {
+		t.Errorf("expected closedNonceCount to be 1, got %d", resp.ClosedNonceCount)
 	}
-}
-
-func TestServicer_LeaseTicket_SameTicketDoubleLeaseWhenLineageFull(t *testing.T) {
-	lineageId := createLineage(t)
-
-	for i := 0; i < maxLeasedNonceCount-1; i++ {
-		request := &api.TicketLeaseRequest{
-			ExtIds: []string{fmt.Sprintf("tx%d", i)},
-		}
 
-		if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
-			t.Errorf("can not lease ticket %s", err)
-		}
+	if resp.Version != 3 {
+		t.Errorf("expected version to be 3, got %d", resp.Version)
 	}
 
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"test-tx"},
+	if resp.CreatedAt == nil {
+		t.Errorf("expected createdAt to be set")
 	}
+}
 
-	if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
-		t.Errorf("can not lease last ticket %s", err)
+func TestServicer_GetLineageById_NoSuchLineageError(t *testing.T) {
+	id, _ := uuid.NewUUID()
+
+	_, err := victim.GetLineageById(ctx, id.String())
+	if err == nil || err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected NoSuchLineage error, got %s", err)
 	}
+}
 
-	if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
-		t.Errorf("can not double lease last ticket. should be idempotent. %s", err)
+func TestServicer_GetLineageById_InvalidRequestError(t *testing.T) {
+	_, err := victim.GetLineageById(ctx, "not-a-uuid")
+	if err == nil || err != ticket.ErrInvalidRequest {
+		t.Errorf("expected InvalidRequest error, got %s", err)
 	}
 }
 
-func TestServicer_CloseTicket(t *testing.T) {
-	lineageId := createLineage(t)
+func TestServicer_CreateLineage_WithLabels(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
 
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"tx1"},
+	labels := api.Labels{"chain": "1", "env": "prod"}
+	createLineageResponse, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+		Labels:              &labels,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
 	}
 
-	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	resp, err := victim.GetLineageById(ctx, createLineageResponse.Id)
 	if err != nil {
-		t.Errorf("can not lease ticket %s", err)
+		t.Fatalf("can not retrieve lineage %s", err)
 	}
 
-	nonce := ensureAndGetSingleNonce(t, resp)
-
-	if nonce != 0 {
-		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	if !reflect.DeepEqual(resp.Labels, labels) {
+		t.Errorf("expected labels %v, got %v", labels, resp.Labels)
 	}
 
-	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
-	if err != nil {
-		t.Errorf("can not close leased ticket %s", err)
-	}
 }
 
-func TestServicer_CloseTicket_NoSuchLineage(t *testing.T) {
-	lineageId, _ := uuid.NewUUID()
+func TestServicer_CreateLineage_InvalidLabels(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
 
-	err := victim.CloseTicket(ctx, lineageId.String(), "nonexistent")
-	if err == nil || err != ticket.ErrNoSuchLineage {
-		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	labels := api.Labels{"bad key": "1"}
+	_, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+		Labels:              &labels,
+	})
+	if err == nil || err != ticket.ErrInvalidRequest {
+		t.Errorf("expected InvalidRequest error, got %s", err)
 	}
 }
 
-func TestServicer_CloseTicket_Idempotency(t *testing.T) {
+func TestServicer_UpdateLineage(t *testing.T) {
 	lineageId := createLineage(t)
 
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"tx1"},
-	}
-
-	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	labels := api.Labels{"team": "payments"}
+	resp, err := victim.UpdateLineage(ctx, lineageId, &api.LineageUpdateRequest{Labels: labels})
 	if err != nil {
-		t.Errorf("can not lease ticket %s", err)
+		t.Fatalf("can not update lineage %s", err)
 	}
 
-	nonce := ensureAndGetSingleNonce(t, resp)
+	if !reflect.DeepEqual(resp.Labels, labels) {
+		t.Errorf("expected labels %v, got %v", labels, resp.Labels)
+	}
 
-	if nonce != 0 {
-		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	if resp.Version != 1 {
+		t.Errorf("expected label update to bump the version to 1, got %d", resp.Version)
 	}
 
-	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
+	resp, err = victim.UpdateLineage(ctx, lineageId, &api.LineageUpdateRequest{Labels: labels})
 	if err != nil {
-		t.Errorf("can not close leased ticket %s", err)
+		t.Fatalf("can not update lineage %s", err)
 	}
 
-	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
-	if err != nil {
-		t.Errorf("can not close already closed ticket %s", err)
+	if resp.Version != 1 {
+		t.Errorf("expected unchanged labels to keep version 1, got %d", resp.Version)
 	}
 }
 
-func TestServicer_CloseTicket_NoSuchTicketError(t *testing.T) {
-	lineageId := createLineage(t)
-
-	err := victim.CloseTicket(ctx, lineageId, "nonexistent")
-	if err == nil {
-		t.Error("should not be able to close nonexistent ticket")
-	}
+func TestServicer_UpdateLineage_NoSuchLineageError(t *testing.T) {
+	id, _ := uuid.NewUUID()
 
-	if err != ticket.ErrNoSuchTicket {
-		t.Errorf("expected ErrNoSuchTicket, got %s", err)
+	_, err := victim.UpdateLineage(ctx, id.String(), &api.LineageUpdateRequest{Labels: api.Labels{}})
+	if err == nil || err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected NoSuchLineage error, got %s", err)
 	}
 }
 
-func TestServicer_CloseTicket_Concurrency(t *testing.T) {
-	lineageId := createLineage(t)
+func TestServicer_ListLineages_LabelSelector(t *testing.T) {
+	run, _ := uuid.NewUUID()
 
-	for i := 0; i < maxLeasedNonceCount; i++ {
-		request := &api.TicketLeaseRequest{
-			ExtIds: []string{fmt.Sprintf("tx%d", i)},
+	for i := 0; i < 3; i++ {
+		labels := api.Labels{"run": run.String(), "env": "prod"}
+		if i == 2 {
+			labels["env"] = "staging"
 		}
 
-		_, err := victim.LeaseTicket(ctx, lineageId, request)
+		_, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+			ExtId:               fmt.Sprintf("test-%s-%d", run, i),
+			MaxLeasedNonceCount: maxLeasedNonceCount,
+			Labels:              &labels,
+		})
 		if err != nil {
-			t.Errorf("can not lease ticket %s", err)
+			t.Fatalf("can not create lineage %s", err)
 		}
 	}
 
-	wg := sync.WaitGroup{}
-	for i := 0; i < maxLeasedNonceCount; i++ {
-		wg.Add(1)
-		go func(i int) {
-			defer wg.Done()
-			err := victim.CloseTicket(ctx, lineageId, fmt.Sprintf("tx%d", i))
-			if err != nil {
-				t.Error("unhandled optimistic lock")
-			}
-		}(i)
+	selector := api.LabelSelector(fmt.Sprintf("run=%s,env!=staging", run))
+	limit := 1
+
+	resp, err := victim.ListLineages(ctx, &api.ListLineagesParams{LabelSelector: &selector, Limit: &limit})
+	if err != nil {
+		t.Fatalf("can not list lineages %s", err)
 	}
-	wg.Wait()
-}
 
-func TestServicer_LeaseTicket_InvalidRequestErrorOnClosedExtId(t *testing.T) {
-	lineageId := createLineage(t)
+	if len(resp.Lineages) != 1 || resp.Lineages[0].ExtId != fmt.Sprintf("test-%s-0", run) {
+		t.Errorf("expected first page to contain only the first lineage, got %v", resp.Lineages)
+	}
 
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"tx1"},
+	if resp.NextCursor == nil {
+		t.Fatalf("expected a cursor to the next page")
 	}
 
-	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	resp, err = victim.ListLineages(ctx, &api.ListLineagesParams{LabelSelector: &selector, Limit: &limit,
+		Cursor: resp.NextCursor})
 	if err != nil {
-		t.Errorf("can not lease ticket %s", err)
+		t.Fatalf("can not list lineages %s", err)
 	}
 
-	nonce := ensureAndGetSingleNonce(t, resp)
+	if len(resp.Lineages) != 1 || resp.Lineages[0].ExtId != fmt.Sprintf("test-%s-1", run) {
+		t.Errorf("expected second page to contain only the second lineage, got %v", resp.Lineages)
+	}
 
-	if nonce != 0 {
-		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	if resp.NextCursor != nil {
+		t.Errorf("expected no cursor on the last page")
 	}
+}
 
-	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
+func TestServicer_ListLineages_EmptyExtId(t *testing.T) {
+	tenantCtx := ticket.WithNamespace(ctx, namespaceTenant)
+	extIdUUID, _ := uuid.NewUUID()
+
+	for _, extId := range []string{"", fmt.Sprintf("test-%s", extIdUUID)} {
+		_, err := victim.CreateLineage(tenantCtx, &api.LineageCreationRequest{
+			ExtId:               extId,
+			MaxLeasedNonceCount: maxLeasedNonceCount,
+		})
+		if err != nil {
+			t.Fatalf("can not create lineage %s", err)
+		}
+	}
+
+	limit := 1
+	resp, err := victim.ListLineages(tenantCtx, &api.ListLineagesParams{Limit: &limit})
 	if err != nil {
-		t.Errorf("can not close leased ticket %s", err)
+		t.Fatalf("can not list lineages %s", err)
 	}
 
-	_, err = victim.LeaseTicket(ctx, lineageId, request)
-	if err == nil {
-		t.Error("should not be able to lease a ticket with a closed ticket's ref")
+	if len(resp.Lineages) != 1 || resp.Lineages[0].ExtId != "" {
+		t.Fatalf("expected first page to contain the lineage with an empty extId, got %v", resp.Lineages)
 	}
 
-	if err != ticket.ErrInvalidRequest {
-		t.Errorf("expected validation error")
+	resp, err = victim.ListLineages(tenantCtx, &api.ListLineagesParams{Limit: &limit, Cursor: resp.NextCursor})
+	if err != nil {
+		t.Fatalf("can not list lineages %s", err)
+	}
+
+	if len(resp.Lineages) != 1 || resp.Lineages[0].ExtId == "" {
+		t.Errorf("expected second page to continue after the lineage with an empty extId, got %v", resp.Lineages)
 	}
 }
 
-func TestServicer_LeaseTicket_TooManyLeasedTicketsError(t *testing.T) {
-	lineageId := createLineage(t)
+func TestServicer_ListLineages_InvalidLabelSelector(t *testing.T) {
+	selector := api.LabelSelector("bad key=1")
 
-	for i := 0; i < maxLeasedNonceCount; i++ {
-		request := &api.TicketLeaseRequest{
-			ExtIds: []string{fmt.Sprintf("tx%d", i)},
-		}
+	_, err := victim.ListLineages(ctx, &api.ListLineagesParams{LabelSelector: &selector})
+	if err == nil || err != ticket.ErrInvalidRequest {
+		t.Errorf("expected InvalidRequest error, got %s", err)
+	}
+}
 
-		_, err := victim.LeaseTicket(ctx, lineageId, request)
+func TestServicer_GetLineageStats_LabelSelector(t *testing.T) {
+	run, _ := uuid.NewUUID()
+
+	var lineageIds []string
+	for i := 0; i < 2; i++ {
+		labels := api.Labels{"run": run.String()}
+		resp, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+			ExtId:               fmt.Sprintf("test-%s-%d", run, i),
+			MaxLeasedNonceCount: maxLeasedNonceCount,
+			Labels:              &labels,
+		})
 		if err != nil {
-			t.Errorf("can not lease ticket %s", err)
+			t.Fatalf("can not create lineage %s", err)
 		}
-	}
 
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"failing-tx"},
+		lineageIds = append(lineageIds, resp.Id)
 	}
 
-	_, err := victim.LeaseTicket(ctx, lineageId, request)
-	if err == nil || err != ticket.ErrTooManyLeasedTickets {
-		t.Errorf("expected error to be ErrTooManyLeasedTickets")
+	for _, lineageId := range lineageIds {
+		if _, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2"}}); err != nil {
+			t.Fatalf("can not lease tickets %s", err)
+		}
+
+		if err := victim.CloseTicket(ctx, lineageId, "tx1"); err != nil {
+			t.Fatalf("can not close ticket %s", err)
+		}
 	}
-}
 
-func TestServicer_LeaseTicket_Concurrency(t *testing.T) {
-	lineageId := createLineage(t)
+	selector := api.LabelSelector(fmt.Sprintf("run=%s", run))
+	resp, err := victim.GetLineageStats(ctx, &api.GetLineageStatsParams{LabelSelector: &selector})
+	if err != nil {
+		t.Fatalf("can not get lineage stats %s", err)
+	}
 
-	wg := sync.WaitGroup{}
-	for i := 0; i < maxLeasedNonceCount; i++ {
-		wg.Add(1)
-		go func(i int) {
-			defer wg.Done()
-			request := &api.TicketLeaseRequest{
-				ExtIds: []string{fmt.Sprintf("tx%d", i)},
-			}
+	expected := api.LineageStatsResponse{
+		LineageCount:        2,
+		LeasedNonceCount:    2,
+		ReleasedNonceCount:  0,
+		ClosedNonceCount:    2,
+		MaxLeasedNonceCount: 2 * maxLeasedNonceCount,
+	}
 
-			_, err := victim.LeaseTicket(ctx, lineageId, request)
-			if err != nil {
-				t.Errorf("can not lease ticket %s", err)
-			}
-		}(i)
+	if *resp != expected {
+		t.Errorf("expected stats %v, got %v", expected, *resp)
 	}
-	wg.Wait()
 }
 
-func TestServicer_LeaseTicket_ReleasedNonceReassignment(t *testing.T) {
-	lineageId := createLineage(t)
+func TestServicer_CreateLineage_SameExtIdInDifferentNamespaces(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+	tenantCtx := ticket.WithNamespace(ctx, namespaceTenant)
 
-	request := &api.TicketLeaseRequest{
-		ExtIds: []string{"tx1"},
+	req := &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID.String()),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
 	}
 
-	_, err := victim.LeaseTicket(ctx, lineageId, request)
+	defaultResp, err := victim.CreateLineage(ctx, req)
 	if err != nil {
-		t.Errorf("can not lease initial ticket %s", err)
+		t.Fatalf("can not create lineage in default namespace %s", err)
 	}
 
-	err = victim.ReleaseTicket(ctx, lineageId, request.ExtIds[0])
+	tenantResp, err := victim.CreateLineage(tenantCtx, req)
 	if err != nil {
-		t.Errorf("can not release first ticket %s", err)
+		t.Fatalf("can not create lineage with same extId in tenant namespace %s", err)
 	}
-	request = &api.TicketLeaseRequest{
-		ExtIds: []string{"tx2"},
+
+	if defaultResp.Id == tenantResp.Id {
+		t.Errorf("expected distinct lineages in distinct namespaces")
 	}
 
-	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	resp, err := victim.GetLineage(tenantCtx, req.ExtId)
 	if err != nil {
-		t.Errorf("can not lease second ticket %s", err)
+		t.Fatalf("can not retrieve lineage %s", err)
 	}
 
-	nonce := ensureAndGetSingleNonce(t, resp)
-
-	if nonce != 0 {
-		t.Errorf("expected released nonce 0 to be reused on second tx, got %d", nonce)
+	if resp.Id != tenantResp.Id || resp.Namespace != namespaceTenant {
+		t.Errorf("expected lineage %s of namespace %s, got %s of namespace %s", tenantResp.Id, namespaceTenant,
+			resp.Id, resp.Namespace)
 	}
 }
 
-func TestServicer_ReleaseTicket_NoSuchLineage(t *testing.T) {
+func TestServicer_NamespaceIsolation(t *testing.T) {
+	tenantCtx := ticket.WithNamespace(ctx, namespaceTenant)
+	lineageId := createLineage(t)
+
+	if _, err := victim.GetLineageById(tenantCtx, lineageId); err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	if _, err := victim.LeaseTicket(tenantCtx, lineageId, request); err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+
+	if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	if _, err := victim.GetTicket(tenantCtx, lineageId, "tx1"); err != ticket.ErrNoSuchTicket {
+		t.Errorf("expected ErrNoSuchTicket, got %s", err)
+	}
+
+	if err := victim.CloseTicket(tenantCtx, lineageId, "tx1"); err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_CreateLineage_NoSuchNamespace(t *testing.T) {
+	_, err := victim.CreateLineage(ticket.WithNamespace(ctx, "unknown"), &api.LineageCreationRequest{
+		ExtId:               "test",
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+	})
+	if err == nil || err != ticket.ErrNoSuchNamespace {
+		t.Errorf("expected ErrNoSuchNamespace, got %s", err)
+	}
+}
+
+func TestServicer_CreateLineage_NamespaceLimits(t *testing.T) {
+	limitedCtx := ticket.WithNamespace(ctx, namespaceLimitedTenant)
+
+	_, err := victim.CreateLineage(limitedCtx, &api.LineageCreationRequest{
+		ExtId:               "test-too-many-leases",
+		MaxLeasedNonceCount: maxLeasedNonceCount + 1,
+	})
+	if err == nil || err != ticket.ErrNamespaceLimitExceeded {
+		t.Errorf("expected ErrNamespaceLimitExceeded, got %s", err)
+	}
+
+	_, err = victim.CreateLineage(limitedCtx, &api.LineageCreationRequest{
+		ExtId:               "test-first",
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	_, err = victim.CreateLineage(limitedCtx, &api.LineageCreationRequest{
+		ExtId:               "test-second",
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+	})
+	if err == nil || err != ticket.ErrNamespaceLimitExceeded {
+		t.Errorf("expected ErrNamespaceLimitExceeded, got %s", err)
+	}
+}
+
+func TestServicer_CreateLineage_NamespaceLimits_Concurrency(t *testing.T) {
+	concurrentCtx := ticket.WithNamespace(ctx, namespaceConcurrentTenant)
+
+	var created, rejected int
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4*concurrentTenantMaxLineageCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := victim.CreateLineage(concurrentCtx, &api.LineageCreationRequest{
+				ExtId:               fmt.Sprintf("test-%d", i),
+				MaxLeasedNonceCount: maxLeasedNonceCount,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch err {
+			case nil:
+				created++
+			case ticket.ErrNamespaceLimitExceeded:
+				rejected++
+			default:
+				t.Errorf("can not create lineage %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if created != concurrentTenantMaxLineageCount || rejected != 3*concurrentTenantMaxLineageCount {
+		t.Errorf("expected %d lineages to be created, got %d created and %d rejected",
+			concurrentTenantMaxLineageCount, created, rejected)
+	}
+}
+
+func TestServicer_CloneLineage(t *testing.T) {
+	lineageId := createLineage(t)
+
+	if _, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2", "tx3"}}); err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx1"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	extIdUUID, _ := uuid.NewUUID()
+	includeTickets := true
+
+	clone, err := victim.CloneLineage(ctx, lineageId, &api.LineageCloneRequest{
+		ExtId:          fmt.Sprintf("test-%s", extIdUUID),
+		IncludeTickets: &includeTickets,
+	})
+	if err != nil {
+		t.Fatalf("can not clone lineage %s", err)
+	}
+
+	source, err := victim.GetLineageById(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not retrieve lineage %s", err)
+	}
+
+	if clone.Id == source.Id || clone.NextNonce != source.NextNonce ||
+		clone.LeasedNonceCount != source.LeasedNonceCount ||
+		clone.ReleasedNonceCount != source.ReleasedNonceCount ||
+		clone.ClosedNonceCount != source.ClosedNonceCount {
+
+		t.Errorf("expected clone %v to copy the counters of %v", clone, source)
+	}
+
+	resp, err := victim.GetTicket(ctx, clone.Id, "tx3")
+	if err != nil {
+		t.Fatalf("can not get cloned ticket %s", err)
+	}
+
+	if (*resp.Leases)[0].Nonce != 2 || (*resp.Leases)[0].State != api.TicketLeaseStateLeased {
+		t.Errorf("expected cloned ticket to keep its nonce and state")
+	}
+
+	events, err := victim.ListLineageEvents(ctx, clone.Id, &api.ListLineageEventsParams{})
+	if err != nil {
+		t.Fatalf("can not list events of clone %s", err)
+	}
+
+	if len(events.Events) != 1 || events.Events[0].Type != api.LineageEventTypeLineageCreated {
+		t.Errorf("expected copied tickets to record no events besides the creation of the clone, got %v",
+			events.Events)
+	}
+
+	sourceHistory, err := victim.GetTicketHistory(ctx, lineageId, "tx2")
+	if err != nil {
+		t.Fatalf("can not get ticket history %s", err)
+	}
+
+	cloneHistory, err := victim.GetTicketHistory(ctx, clone.Id, "tx2")
+	if err != nil {
+		t.Fatalf("can not get cloned ticket history %s", err)
+	}
+
+	if !reflect.DeepEqual(cloneHistory.Entries, sourceHistory.Entries) {
+		t.Errorf("expected cloned ticket to take over the history %v, got %v", sourceHistory.Entries,
+			cloneHistory.Entries)
+	}
+
+	resp, err = victim.LeaseTicket(ctx, clone.Id, &api.TicketLeaseRequest{ExtIds: []string{"tx4"}})
+	if err != nil {
+		t.Fatalf("can not lease ticket on clone %s", err)
+	}
+
+	if nonce := ensureAndGetSingleNonce(t, resp); nonce != 0 {
+		t.Errorf("expected released nonce 0 to be reused on the clone, got %d", nonce)
+	}
+
+	source, err = victim.GetLineageById(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not retrieve lineage %s", err)
+	}
+
+	if source.ReleasedNonceCount != 1 {
+		t.Errorf("expected source lineage to be left untouched")
+	}
+}
+
+func TestServicer_CloneLineage_WithoutTickets(t *testing.T) {
+	lineageId := createLineage(t)
+
+	if _, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2", "tx3"}}); err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx1"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	extIdUUID, _ := uuid.NewUUID()
+	clone, err := victim.CloneLineage(ctx, lineageId, &api.LineageCloneRequest{
+		ExtId: fmt.Sprintf("test-%s", extIdUUID),
+	})
+	if err != nil {
+		t.Fatalf("can not clone lineage %s", err)
+	}
+
+	// only the released nonce is carried over, the leased and closed tickets stay with the source lineage
+	if clone.NextNonce != 3 || clone.LeasedNonceCount != 1 || clone.ReleasedNonceCount != 1 ||
+		clone.ClosedNonceCount != 0 {
+
+		t.Errorf("expected clone to count only its released nonce, got %v", clone)
+	}
+
+	if _, err := victim.GetTicket(ctx, clone.Id, "tx3"); err != ticket.ErrNoSuchTicket {
+		t.Errorf("expected ErrNoSuchTicket, got %s", err)
+	}
+
+	var extIds []string
+	for i := 0; i < maxLeasedNonceCount; i++ {
+		extIds = append(extIds, fmt.Sprintf("clone-tx%d", i))
+	}
+
+	if _, err := victim.LeaseTicket(ctx, clone.Id, &api.TicketLeaseRequest{ExtIds: extIds}); err != nil {
+		t.Fatalf("can not lease up to the limit on the clone %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ctx, clone.Id, &api.TicketLeaseRequest{ExtIds: []string{"clone-tx-over"}})
+	if err != ticket.ErrTooManyLeasedTickets {
+		t.Errorf("expected ErrTooManyLeasedTickets past the limit, got %v", err)
+	}
+}
+
+func TestServicer_CloneLineage_ExistingExtIdFails(t *testing.T) {
+	lineageId := createLineage(t)
+
+	source, err := victim.GetLineageById(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not retrieve lineage %s", err)
+	}
+
+	_, err = victim.CloneLineage(ctx, lineageId, &api.LineageCloneRequest{ExtId: source.ExtId})
+	if err == nil || err != ticket.ErrLineageConflict {
+		t.Errorf("expected ErrLineageConflict, got %s", err)
+	}
+}
+
+func TestServicer_CloneLineage_NoSuchLineage(t *testing.T) {
+	id, _ := uuid.NewUUID()
+
+	_, err := victim.CloneLineage(ctx, id.String(), &api.LineageCloneRequest{ExtId: "test-clone"})
+	if err == nil || err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_GetLineageByAddress(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+
+	chainId := int64(1)
+	address := "0xABCDEF" + strings.ReplaceAll(extIdUUID.String(), "-", "") + "01"
+
+	createLineageResponse, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+		ChainId:             &chainId,
+		Address:             &address,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	resp, err := victim.GetLineageByAddress(ctx, chainId, strings.ToLower(address))
+	if err != nil {
+		t.Fatalf("can not get lineage by address %s", err)
+	}
+
+	if resp.Id != createLineageResponse.Id {
+		t.Errorf("expected lineage %s, got %s", createLineageResponse.Id, resp.Id)
+	}
+
+	if resp.ChainId == nil || *resp.ChainId != chainId {
+		t.Errorf("expected chainId %d, got %v", chainId, resp.ChainId)
+	}
+
+	if resp.Address == nil || *resp.Address != strings.ToLower(address) {
+		t.Errorf("expected normalized address %s, got %v", strings.ToLower(address), resp.Address)
+	}
+
+	_, err = victim.GetLineageByAddress(ctx, chainId+1, address)
+	if err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+
+	_, err = victim.GetLineageByAddress(ctx, chainId, "0x1234")
+	if err != ticket.ErrInvalidRequest {
+		t.Errorf("expected ErrInvalidRequest, got %s", err)
+	}
+}
+
+func TestServicer_CreateLineage_DuplicateAddressFails(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+
+	chainId := int64(137)
+	address := "0xABCDEF" + strings.ReplaceAll(extIdUUID.String(), "-", "") + "02"
+
+	_, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+		ChainId:             &chainId,
+		Address:             &address,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	lowerAddress := strings.ToLower(address)
+	_, err = victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s-other", extIdUUID),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+		ChainId:             &chainId,
+		Address:             &lowerAddress,
+	})
+	if err != ticket.ErrLineageConflict {
+		t.Errorf("expected ErrLineageConflict, got %s", err)
+	}
+}
+
+func TestServicer_CreateLineage_ChainIdWithoutAddressFails(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+
+	chainId := int64(1)
+	_, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+		ChainId:             &chainId,
+	})
+	if err != ticket.ErrInvalidRequest {
+		t.Errorf("expected ErrInvalidRequest, got %s", err)
+	}
+}
+
+func TestServicer_ListLineageEvents(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1", "tx2"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx1"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	_, err = victim.UpdateLineage(ctx, lineageId, &api.LineageUpdateRequest{Labels: api.Labels{"env": "prod"}})
+	if err != nil {
+		t.Fatalf("can not update lineage %s", err)
+	}
+
+	resp, err := victim.ListLineageEvents(ctx, lineageId, &api.ListLineageEventsParams{})
+	if err != nil {
+		t.Fatalf("can not list lineage events %s", err)
+	}
+
+	expectedTypes := []api.LineageEventType{
+		api.LineageEventTypeLineageCreated,
+		api.LineageEventTypeTicketLeased,
+		api.LineageEventTypeTicketLeased,
+		api.LineageEventTypeTicketReleased,
+		api.LineageEventTypeTicketClosed,
+		api.LineageEventTypeLineageUpdated,
+	}
+	if len(resp.Events) != len(expectedTypes) {
+		t.Fatalf("expected %d events, got %d", len(expectedTypes), len(resp.Events))
+	}
+
+	for i, event := range resp.Events {
+		if event.Seq != int64(i+1) {
+			t.Errorf("expected event seq %d, got %d", i+1, event.Seq)
+		}
+
+		if event.Type != expectedTypes[i] {
+			t.Errorf("expected event %d to be %s, got %s", event.Seq, expectedTypes[i], event.Type)
+		}
+	}
+
+	after := int64(4)
+	limit := 1
+	resp, err = victim.ListLineageEvents(ctx, lineageId, &api.ListLineageEventsParams{After: &after, Limit: &limit})
+	if err != nil {
+		t.Fatalf("can not list lineage events %s", err)
+	}
+
+	if len(resp.Events) != 1 || resp.Events[0].Seq != 5 || *resp.Events[0].ExtId != "tx2" {
+		t.Errorf("expected the close event of tx2 with seq 5, got %v", resp.Events)
+	}
+}
+
+func TestServicer_ListLineageEvents_LimitApproaching(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+	lineage, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID.String()),
+		MaxLeasedNonceCount: 10,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		extId := fmt.Sprintf("tx%d", i)
+		if _, err := victim.LeaseTicket(ctx, lineage.Id, &api.TicketLeaseRequest{ExtIds: []string{extId}}); err != nil {
+			t.Fatalf("can not lease ticket %s", err)
+		}
+	}
+
+	resp, err := victim.ListLineageEvents(ctx, lineage.Id, &api.ListLineageEventsParams{})
+	if err != nil {
+		t.Fatalf("can not list lineage events %s", err)
+	}
+
+	var approaching []int64
+	for _, event := range resp.Events {
+		if event.Type == api.LineageEventTypeLineageLimitApproaching {
+			approaching = append(approaching, event.Seq)
+		}
+	}
+
+	if len(approaching) != 1 {
+		t.Errorf("expected a single lineage_limit_approaching event, got %d", len(approaching))
+	}
+}
+
+func TestServicer_LeaseTicket_Wait(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+	lineage, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID.String()),
+		MaxLeasedNonceCount: 1,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	if _, err := victim.LeaseTicket(ctx, lineage.Id, &api.TicketLeaseRequest{ExtIds: []string{"tx1"}}); err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	waitCtx := ticket.WithWait(ctx, 100*time.Millisecond)
+	_, err = victim.LeaseTicket(waitCtx, lineage.Id, &api.TicketLeaseRequest{ExtIds: []string{"tx2"}})
+	if err != ticket.ErrTooManyLeasedTickets {
+		t.Fatalf("expected %s, got %v", ticket.ErrTooManyLeasedTickets, err)
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		if err := victim.ReleaseTicket(ctx, lineage.Id, "tx1"); err != nil {
+			t.Errorf("can not release ticket %s", err)
+		}
+	}()
+
+	waitCtx = ticket.WithWait(ctx, 5*time.Second)
+	resp, err := victim.LeaseTicket(waitCtx, lineage.Id, &api.TicketLeaseRequest{ExtIds: []string{"tx2"}})
+	if err != nil {
+		t.Fatalf("can not lease ticket after waiting %s", err)
+	}
+
+	ensureAndGetSingleNonce(t, resp)
+}
+
+func TestServicer_SubscribeLineageEvents(t *testing.T) {
+	lineageId := createLineage(t)
+
+	lineage := victim.SubscribeLineageEvents(ctx, lineageId)
+	defer lineage.Close()
+	namespace := victim.SubscribeLineageEvents(ctx, "")
+	defer namespace.Close()
+
+	cursor, err := victim.GetLineagesEventCursor(ctx)
+	if err != nil {
+		t.Fatalf("can not get event cursor %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx1"}})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	for name, sub := range map[string]*ticket.EventSubscription{"lineage": lineage, "namespace": namespace} {
+		select {
+		case <-sub.Wake():
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected subscription to the %s to be woken by the lease", name)
+		}
+
+		if sub.TxId() < cursor.TxId {
+			t.Errorf("expected subscription to the %s to wait for a transaction from %d on, got %d", name,
+				cursor.TxId, sub.TxId())
+		}
+	}
+}
+
+func TestServicer_ListLineagesEvents(t *testing.T) {
+	run := strings.ReplaceAll(uuid.NewString(), "-", "")
+	prefix := fmt.Sprintf("test-%s", run)
+
+	cursor, err := victim.GetLineagesEventCursor(ctx)
+	if err != nil {
+		t.Fatalf("can not get lineages event cursor %s", err)
+	}
+
+	var lineageIds []string
+	for _, env := range []string{"prod", "staging"} {
+		resp, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+			ExtId:               fmt.Sprintf("%s-%s", prefix, env),
+			MaxLeasedNonceCount: maxLeasedNonceCount,
+			Labels:              &api.Labels{"env": env},
+		})
+		if err != nil {
+			t.Fatalf("can not create lineage %s", err)
+		}
+		lineageIds = append(lineageIds, resp.Id)
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageIds[0], &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2"}})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	seq, err := victim.GetLineageEventSeq(ctx, lineageIds[0])
+	if err != nil {
+		t.Fatalf("can not get lineage event seq %s", err)
+	}
+	if seq != 3 {
+		t.Errorf("expected the lease events to follow the creation event, got seq=%d", seq)
+	}
+
+	params := &api.StreamLineagesEventsParams{ExtIdPrefix: &prefix}
+	events, next, err := victim.ListLineagesEvents(ctx, params, cursor, 10)
+	if err != nil {
+		t.Fatalf("can not list lineages events %s", err)
+	}
+	expected := []string{lineageIds[0] + "/1", lineageIds[1] + "/1", lineageIds[0] + "/2", lineageIds[0] + "/3"}
+	if !reflect.DeepEqual(eventKeys(events), expected) {
+		t.Errorf("expected events %v, got %v", expected, eventKeys(events))
+	}
+	if !next.After(events[len(events)-1].Cursor) {
+		t.Errorf("expected a short page to move the cursor to the horizon, got %v", next)
+	}
+
+	events, _, err = victim.ListLineagesEvents(ctx, params, next, 10)
+	if err != nil {
+		t.Fatalf("can not list lineages events %s", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events after the cursor, got %v", eventKeys(events))
+	}
+
+	// a full page continues after its last event
+	events, next, err = victim.ListLineagesEvents(ctx, params, cursor, 1)
+	if err != nil {
+		t.Fatalf("can not list lineages events %s", err)
+	}
+	if next != events[0].Cursor {
+		t.Errorf("expected a full page to continue after its last event, got %v", next)
+	}
+	events, _, err = victim.ListLineagesEvents(ctx, params, next, 10)
+	if err != nil {
+		t.Fatalf("can not list lineages events %s", err)
+	}
+	if !reflect.DeepEqual(eventKeys(events), expected[1:]) {
+		t.Errorf("expected events %v, got %v", expected[1:], eventKeys(events))
+	}
+
+	selector := api.LabelSelector("env=staging")
+	events, _, err = victim.ListLineagesEvents(ctx, &api.StreamLineagesEventsParams{
+		ExtIdPrefix:   &prefix,
+		LabelSelector: &selector,
+	}, cursor, 10)
+	if err != nil {
+		t.Fatalf("can not list lineages events %s", err)
+	}
+	expected = []string{lineageIds[1] + "/1"}
+	if !reflect.DeepEqual(eventKeys(events), expected) {
+		t.Errorf("expected events %v, got %v", expected, eventKeys(events))
+	}
+}
+
+func TestServicer_ListLineagesEvents_InvalidSelector(t *testing.T) {
+	selector := api.LabelSelector("bad key=1")
+	cursor, err := victim.GetLineagesEventCursor(ctx)
+	if err != nil {
+		t.Fatalf("can not get lineages event cursor %s", err)
+	}
+
+	_, _, err = victim.ListLineagesEvents(ctx, &api.StreamLineagesEventsParams{LabelSelector: &selector}, cursor, 10)
+	if err != ticket.ErrInvalidRequest {
+		t.Errorf("expected ErrInvalidRequest, got %v", err)
+	}
+}
+
+func eventKeys(events []ticket.CursoredLineageEvent) []string {
+	keys := make([]string, 0, len(events))
+	for _, e := range events {
+		keys = append(keys, fmt.Sprintf("%s/%d", e.Event.LineageId, e.Event.Seq))
+	}
+
+	return keys
+}
+
+func TestServicer_OptimisticLockRetriesExhausted(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2"}})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	contendLineage(t, lineageId)
+
+	_, err = victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx3"}})
+	if err != ticket.ErrTooManyConcurrentRequests {
+		t.Errorf("expected lease to fail with ErrTooManyConcurrentRequests, got %v", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx1"); err != ticket.ErrTooManyConcurrentRequests {
+		t.Errorf("expected release to fail with ErrTooManyConcurrentRequests, got %v", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != ticket.ErrTooManyConcurrentRequests {
+		t.Errorf("expected close to fail with ErrTooManyConcurrentRequests, got %v", err)
+	}
+
+	lineage, err := victim.GetLineageById(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not get lineage %s", err)
+	}
+
+	if lineage.NextNonce != 2 || lineage.LeasedNonceCount != 2 || lineage.ReleasedNonceCount != 0 ||
+		lineage.ClosedNonceCount != 0 {
+
+		t.Errorf("expected the failed calls to leave the lineage unchanged, got %+v", lineage)
+	}
+}
+
+// contendLineage makes every change of the tickets of the lineage fail the optimistic lock until the test ends, as
+// if concurrent requests always changed the lineage first.
+func contendLineage(t *testing.T, lineageId string) {
+	_, err := testDb.ExecContext(ctx, fmt.Sprintf(`create function contend_lineage() returns trigger
+    language plpgsql
+as
+$$
+begin
+    if (case when tg_op = 'DELETE' then old.lineage_id else new.lineage_id end) = '%s' then
+        raise exception 'optimistic_lock';
+    end if;
+
+    return case when tg_op = 'DELETE' then old else new end;
+end;
+$$`, uuid.MustParse(lineageId)))
+	if err != nil {
+		t.Fatalf("can not create contention function %s", err)
+	}
+
+	_, err = testDb.ExecContext(ctx, `create trigger tickets_contend_lineage_trg
+before insert or update or delete on tickets
+for each row execute function contend_lineage()`)
+	if err != nil {
+		t.Fatalf("can not create contention trigger %s", err)
+	}
+
+	t.Cleanup(func() {
+		if _, err := testDb.ExecContext(ctx, `drop trigger tickets_contend_lineage_trg on tickets;
+drop function contend_lineage()`); err != nil {
+			t.Errorf("can not drop contention trigger %s", err)
+		}
+	})
+}
+
+func TestServicer_ListLineageEvents_NoSuchLineage(t *testing.T) {
+	aUuid, _ := uuid.NewUUID()
+
+	_, err := victim.ListLineageEvents(ctx, aUuid.String(), &api.ListLineageEventsParams{})
+	if err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_IdempotencyKey(t *testing.T) {
+	lineageId := createLineage(t)
+
+	stored, err := victim.ReserveIdempotencyKey(ctx, lineageId, "key-1", "fingerprint-1")
+	if err != nil || stored != nil {
+		t.Fatalf("expected to reserve idempotency key, got %v %s", stored, err)
+	}
+
+	_, err = victim.ReserveIdempotencyKey(ctx, lineageId, "key-1", "fingerprint-1")
+	if err != ticket.ErrIdempotencyKeyInProgress {
+		t.Errorf("expected ErrIdempotencyKeyInProgress, got %s", err)
+	}
+
+	resp := &ticket.IdempotentResponse{
+		StatusCode:  http.StatusOK,
+		ContentType: "application/json",
+		Body:        []byte(`{"leases":[]}`),
+	}
+	if err := victim.CompleteIdempotencyKey(ctx, lineageId, "key-1", resp, time.Hour); err != nil {
+		t.Fatalf("can not complete idempotency key %s", err)
+	}
+
+	stored, err = victim.ReserveIdempotencyKey(ctx, lineageId, "key-1", "fingerprint-1")
+	if err != nil {
+		t.Fatalf("can not reserve idempotency key %s", err)
+	}
+
+	if !reflect.DeepEqual(stored, resp) {
+		t.Errorf("expected stored response %v, got %v", resp, stored)
+	}
+
+	_, err = victim.ReserveIdempotencyKey(ctx, lineageId, "key-1", "fingerprint-2")
+	if err != ticket.ErrIdempotencyKeyReused {
+		t.Errorf("expected ErrIdempotencyKeyReused, got %s", err)
+	}
+}
+
+func TestServicer_IdempotencyKey_Release(t *testing.T) {
+	lineageId := createLineage(t)
+
+	if _, err := victim.ReserveIdempotencyKey(ctx, lineageId, "key-1", "fingerprint-1"); err != nil {
+		t.Fatalf("can not reserve idempotency key %s", err)
+	}
+
+	if err := victim.ReleaseIdempotencyKey(ctx, lineageId, "key-1"); err != nil {
+		t.Fatalf("can not release idempotency key %s", err)
+	}
+
+	stored, err := victim.ReserveIdempotencyKey(ctx, lineageId, "key-1", "fingerprint-2")
+	if err != nil || stored != nil {
+		t.Errorf("expected to reserve released idempotency key, got %v %s", stored, err)
+	}
+}
+
+func TestServicer_LeaseTicket(t *testing.T) {
+	lineageId := createLineage(t)
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("can not lease ticket %s", err)
+	}
+
+	nonce := ensureAndGetSingleNonce(t, resp)
+
+	if nonce != 0 {
+		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	}
+}
+
+func TestServicer_LeaseTicket_ExpectedVersion(t *testing.T) {
+	lineageId := createLineage(t)
+
+	version, err := victim.GetLineageVersion(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not get lineage version %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ticket.WithExpectedVersion(ctx, version), lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ticket.WithExpectedVersion(ctx, version), lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx2"},
+	})
+	if err != ticket.ErrLineageVersionMismatch {
+		t.Errorf("expected ErrLineageVersionMismatch, got %s", err)
+	}
+
+	err = victim.CloseTicket(ticket.WithExpectedVersion(ctx, version), lineageId, "tx1")
+	if err != ticket.ErrLineageVersionMismatch {
+		t.Errorf("expected ErrLineageVersionMismatch, got %s", err)
+	}
+
+	resp, err := victim.GetTicket(ctx, lineageId, "tx1")
+	if err != nil {
+		t.Fatalf("can not get ticket %s", err)
+	}
+
+	if (*resp.Leases)[0].State != api.TicketLeaseStateLeased {
+		t.Errorf("expected ticket to stay leased, got %s", (*resp.Leases)[0].State)
+	}
+}
+
+func TestServicer_LeaseTicket_NoSuchLineage(t *testing.T) {
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	lineageId, _ := uuid.NewUUID()
+
+	_, err := victim.LeaseTicket(ctx, lineageId.String(), request)
+	if err == nil || err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_LeaseTicket_WithSameNonce(t *testing.T) {
+	lineageId := createLineage(t)
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("can not lease ticket %s", err)
+	}
+
+	nonce := ensureAndGetSingleNonce(t, resp)
+
+	if nonce != 0 {
+		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("fail on multiple lease operations, should be idempotent %s", err)
+	}
+
+	if nonce != 0 {
+		t.Errorf("expected second ticket creation request to have no side effects and reuse initially "+
+			"assigned nonce 0, got %d", nonce)
+	}
+}
+
+func TestServicer_LeaseTicket_SameTicketDoubleLeaseWhenLineageFull(t *testing.T) {
+	lineageId := createLineage(t)
+
+	for i := 0; i < maxLeasedNonceCount-1; i++ {
+		request := &api.TicketLeaseRequest{
+			ExtIds: []string{fmt.Sprintf("tx%d", i)},
+		}
+
+		if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
+			t.Errorf("can not lease ticket %s", err)
+		}
+	}
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"test-tx"},
+	}
+
+	if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
+		t.Errorf("can not lease last ticket %s", err)
+	}
+
+	if _, err := victim.LeaseTicket(ctx, lineageId, request); err != nil {
+		t.Errorf("can not double lease last ticket. should be idempotent. %s", err)
+	}
+}
+
+func TestServicer_CloseTicket(t *testing.T) {
+	lineageId := createLineage(t)
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("can not lease ticket %s", err)
+	}
+
+	nonce := ensureAndGetSingleNonce(t, resp)
+
+	if nonce != 0 {
+		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	}
+
+	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
+	if err != nil {
+		t.Errorf("can not close leased ticket %s", err)
+	}
+}
+
+func TestServicer_CloseTicket_NoSuchLineage(t *testing.T) {
+	lineageId, _ := uuid.NewUUID()
+
+	err := victim.CloseTicket(ctx, lineageId.String(), "nonexistent")
+	if err == nil || err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_CloseTicket_Idempotency(t *testing.T) {
+	lineageId := createLineage(t)
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("can not lease ticket %s", err)
+	}
+
+	nonce := ensureAndGetSingleNonce(t, resp)
+
+	if nonce != 0 {
+		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	}
+
+	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
+	if err != nil {
+		t.Errorf("can not close leased ticket %s", err)
+	}
+
+	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
+	if err != nil {
+		t.Errorf("can not close already closed ticket %s", err)
+	}
+}
+
+func TestServicer_CloseTicket_NoSuchTicketError(t *testing.T) {
+	lineageId := createLineage(t)
+
+	err := victim.CloseTicket(ctx, lineageId, "nonexistent")
+	if err == nil {
+		t.Error("should not be able to close nonexistent ticket")
+	}
+
+	if err != ticket.ErrNoSuchTicket {
+		t.Errorf("expected ErrNoSuchTicket, got %s", err)
+	}
+}
+
+func TestServicer_CloseTicket_Concurrency(t *testing.T) {
+	lineageId := createLineage(t)
+
+	for i := 0; i < maxLeasedNonceCount; i++ {
+		request := &api.TicketLeaseRequest{
+			ExtIds: []string{fmt.Sprintf("tx%d", i)},
+		}
+
+		_, err := victim.LeaseTicket(ctx, lineageId, request)
+		if err != nil {
+			t.Errorf("can not lease ticket %s", err)
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < maxLeasedNonceCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := victim.CloseTicket(ctx, lineageId, fmt.Sprintf("tx%d", i))
+			if err != nil {
+				t.Error("unhandled optimistic lock")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestServicer_LeaseTicket_InvalidRequestErrorOnClosedExtId(t *testing.T) {
+	lineageId := createLineage(t)
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("can not lease ticket %s", err)
+	}
+
+	nonce := ensureAndGetSingleNonce(t, resp)
+
+	if nonce != 0 {
+		t.Errorf("expected first leased nonce to be 0, got %d", nonce)
+	}
+
+	err = victim.CloseTicket(ctx, lineageId, request.ExtIds[0])
+	if err != nil {
+		t.Errorf("can not close leased ticket %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageId, request)
+	if err == nil {
+		t.Error("should not be able to lease a ticket with a closed ticket's ref")
+	}
+
+	if err != ticket.ErrInvalidRequest {
+		t.Errorf("expected validation error")
+	}
+}
+
+func TestServicer_LeaseTicket_TooManyLeasedTicketsError(t *testing.T) {
+	lineageId := createLineage(t)
+
+	for i := 0; i < maxLeasedNonceCount; i++ {
+		request := &api.TicketLeaseRequest{
+			ExtIds: []string{fmt.Sprintf("tx%d", i)},
+		}
+
+		_, err := victim.LeaseTicket(ctx, lineageId, request)
+		if err != nil {
+			t.Errorf("can not lease ticket %s", err)
+		}
+	}
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"failing-tx"},
+	}
+
+	_, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err == nil || err != ticket.ErrTooManyLeasedTickets {
+		t.Errorf("expected error to be ErrTooManyLeasedTickets")
+	}
+}
+
+func TestServicer_LeaseTicket_Concurrency(t *testing.T) {
+	lineageId := createLineage(t)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < maxLeasedNonceCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			request := &api.TicketLeaseRequest{
+				ExtIds: []string{fmt.Sprintf("tx%d", i)},
+			}
+
+			_, err := victim.LeaseTicket(ctx, lineageId, request)
+			if err != nil {
+				t.Errorf("can not lease ticket %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestServicer_LeaseTicket_ReleasedNonceReassignment(t *testing.T) {
+	lineageId := createLineage(t)
+
+	request := &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	}
+
+	_, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("can not lease initial ticket %s", err)
+	}
+
+	err = victim.ReleaseTicket(ctx, lineageId, request.ExtIds[0])
+	if err != nil {
+		t.Errorf("can not release first ticket %s", err)
+	}
+	request = &api.TicketLeaseRequest{
+		ExtIds: []string{"tx2"},
+	}
+
+	resp, err := victim.LeaseTicket(ctx, lineageId, request)
+	if err != nil {
+		t.Errorf("can not lease second ticket %s", err)
+	}
+
+	nonce := ensureAndGetSingleNonce(t, resp)
+
+	if nonce != 0 {
+		t.Errorf("expected released nonce 0 to be reused on second tx, got %d", nonce)
+	}
+}
+
+func TestServicer_ReleaseTicket_NoSuchLineage(t *testing.T) {
 	lineageId, _ := uuid.NewUUID()
 
 	err := victim.ReleaseTicket(ctx, lineageId.String(), "nonexistent")
@@ -467,20 +1636,147 @@ func TestServicer_ReleaseTicket_Concurrency(t *testing.T) {
 		}
 	}
 
-	wg := sync.WaitGroup{}
-	for i := 0; i < maxLeasedNonceCount; i++ {
-		wg.Add(1)
+	wg := sync.WaitGroup{}
+	for i := 0; i < maxLeasedNonceCount; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			err := victim.ReleaseTicket(ctx, lineageId, fmt.Sprintf("tx%d", i))
+			if err != nil {
+				t.Errorf("unhandled optimistic lock %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+}
+
+func TestServicer_UpdateTickets(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1", "tx2", "tx3", "tx4"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx1"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	before, err := victim.GetLineageById(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not get lineage %s", err)
+	}
+
+	resp, err := victim.UpdateTickets(ctx, lineageId, &api.TicketBulkUpdateRequest{
+		Tickets: []api.TicketBulkUpdateItem{
+			{ExtId: "tx1", State: api.TicketBulkUpdateItemStateClosed},
+			{ExtId: "tx2", State: api.TicketBulkUpdateItemStateReleased},
+			{ExtId: "tx3", State: api.TicketBulkUpdateItemStateClosed},
+			{ExtId: "tx9", State: api.TicketBulkUpdateItemStateReleased},
+		},
+	})
+	if err != nil {
+		t.Fatalf("can not update tickets %s", err)
+	}
+
+	expected := []api.TicketBulkUpdateResult{
+		{ExtId: "tx1", Outcome: api.TicketBulkUpdateResultOutcomeAlreadyClosed},
+		{ExtId: "tx2", Outcome: api.TicketBulkUpdateResultOutcomeReleased},
+		{ExtId: "tx3", Outcome: api.TicketBulkUpdateResultOutcomeClosed},
+		{ExtId: "tx9", Outcome: api.TicketBulkUpdateResultOutcomeNoSuchTicket},
+	}
+	if !reflect.DeepEqual(resp.Results, expected) {
+		t.Errorf("expected results %v, got %v", expected, resp.Results)
+	}
+
+	after, err := victim.GetLineageById(ctx, lineageId)
+	if err != nil {
+		t.Fatalf("can not get lineage %s", err)
+	}
+
+	if after.Version != before.Version+1 {
+		t.Errorf("expected a single version bump from %d, got %d", before.Version, after.Version)
+	}
+
+	if after.ReleasedNonceCount != before.ReleasedNonceCount+1 {
+		t.Errorf("expected releasedNonceCount %d, got %d", before.ReleasedNonceCount+1, after.ReleasedNonceCount)
+	}
+
+	if after.ClosedNonceCount != before.ClosedNonceCount+1 {
+		t.Errorf("expected closedNonceCount %d, got %d", before.ClosedNonceCount+1, after.ClosedNonceCount)
+	}
+}
+
+func TestServicer_UpdateTickets_NoSuchLineage(t *testing.T) {
+	aUuid, _ := uuid.NewUUID()
+
+	_, err := victim.UpdateTickets(ctx, aUuid.String(), &api.TicketBulkUpdateRequest{
+		Tickets: []api.TicketBulkUpdateItem{{ExtId: "tx1", State: api.TicketBulkUpdateItemStateClosed}},
+	})
+	if err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_TransferTicket(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1", "tx2"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	resp, err := victim.TransferTicket(ctx, lineageId, "tx1", &api.TicketTransferRequest{ExtId: "tx1-speedup"})
+	if err != nil {
+		t.Fatalf("can not transfer ticket %s", err)
+	}
+
+	if nonce := ensureAndGetSingleNonce(t, resp); nonce != 0 {
+		t.Errorf("expected the transferred ticket to hold nonce 0, got %d", nonce)
+	}
+
+	oldTicket, err := victim.GetTicket(ctx, lineageId, "tx1")
+	if err != nil {
+		t.Fatalf("can not get ticket %s", err)
+	}
+
+	if (*oldTicket.Leases)[0].State != api.TicketLeaseStateReplaced {
+		t.Errorf("expected the old ticket to be replaced, got %s", (*oldTicket.Leases)[0].State)
+	}
+
+	resp, err = victim.TransferTicket(ctx, lineageId, "tx1", &api.TicketTransferRequest{ExtId: "tx1-speedup"})
+	if err != nil {
+		t.Fatalf("can not retry transfer %s", err)
+	}
+
+	if nonce := ensureAndGetSingleNonce(t, resp); nonce != 0 {
+		t.Errorf("expected the retried transfer to return nonce 0, got %d", nonce)
+	}
+
+	nonceResp, err := victim.GetNonce(ctx, lineageId, 0)
+	if err != nil {
+		t.Fatalf("can not get nonce %s", err)
+	}
+
+	if nonceResp.Status != api.NonceGetResponseStatusLeased || *nonceResp.ExtId != "tx1-speedup" {
+		t.Errorf("expected nonce 0 to be leased by tx1-speedup, got %s %v", nonceResp.Status, nonceResp.ExtId)
+	}
 
-		go func(i int) {
-			defer wg.Done()
-			err := victim.ReleaseTicket(ctx, lineageId, fmt.Sprintf("tx%d", i))
-			if err != nil {
-				t.Errorf("unhandled optimistic lock %s", err)
-			}
-		}(i)
+	_, err = victim.TransferTicket(ctx, lineageId, "tx2", &api.TicketTransferRequest{ExtId: "tx1-speedup"})
+	if err != ticket.ErrInvalidRequest {
+		t.Errorf("expected ErrInvalidRequest, got %s", err)
 	}
-	wg.Wait()
 
+	_, err = victim.TransferTicket(ctx, lineageId, "tx9", &api.TicketTransferRequest{ExtId: "tx9-speedup"})
+	if err != ticket.ErrNoSuchTicket {
+		t.Errorf("expected ErrNoSuchTicket, got %s", err)
+	}
 }
 
 func TestServicer_GetTicket_Leased(t *testing.T) {
@@ -549,6 +1845,349 @@ func TestServicer_GetTicket_NoSuchTicket(t *testing.T) {
 	}
 }
 
+func TestServicer_GetTickets_MissingExtIds(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1", "tx2"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	resp, err := victim.GetTickets(ctx, lineageId, []string{"tx1", "tx2", "tx3"}, false)
+	if err != nil {
+		t.Fatalf("can not get tickets %s", err)
+	}
+
+	if len(*resp.Leases) != 1 || (*resp.Leases)[0].ExtId != "tx1" {
+		t.Errorf("expected only ticket tx1, got %v", *resp.Leases)
+	}
+
+	if !reflect.DeepEqual(*resp.MissingExtIds, []string{"tx2", "tx3"}) {
+		t.Errorf("expected missing ext ids [tx2 tx3], got %v", *resp.MissingExtIds)
+	}
+
+	_, err = victim.GetTickets(ctx, lineageId, []string{"tx1", "tx2"}, true)
+	if err != ticket.ErrNoSuchTicket {
+		t.Errorf("expected ErrNoSuchTicket, got %s", err)
+	}
+
+	resp, err = victim.GetTickets(ctx, lineageId, []string{"tx1"}, true)
+	if err != nil {
+		t.Fatalf("can not get tickets %s", err)
+	}
+
+	if len(*resp.MissingExtIds) != 0 {
+		t.Errorf("expected no missing ext ids, got %v", *resp.MissingExtIds)
+	}
+}
+
+func TestServicer_GetTickets_NoSuchLineage(t *testing.T) {
+	aUuid, _ := uuid.NewUUID()
+
+	_, err := victim.GetTickets(ctx, aUuid.String(), []string{"tx1"}, false)
+	if err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_ListTickets(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1", "tx2", "tx3", "tx4", "tx5"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	limit := 2
+	params := &api.GetTicketsParams{Limit: &limit}
+
+	var extIds []string
+	for page := 0; ; page++ {
+		resp, err := victim.ListTickets(ctx, lineageId, params)
+		if err != nil {
+			t.Fatalf("can not list tickets %s", err)
+		}
+
+		for _, lease := range *resp.Leases {
+			if lease.LeasedAt == nil {
+				t.Errorf("ticket with extId=%s expected to have leasedAt", lease.ExtId)
+			}
+			extIds = append(extIds, lease.ExtId)
+		}
+
+		if resp.NextCursor == nil {
+			break
+		}
+		params.Cursor = resp.NextCursor
+	}
+
+	expected := []string{"tx1", "tx2", "tx3", "tx4", "tx5"}
+	if !reflect.DeepEqual(extIds, expected) {
+		t.Errorf("expected tickets %v, got %v", expected, extIds)
+	}
+
+	state := api.GetTicketsParamsStateLeased
+	minNonce, maxNonce := int64(1), int64(3)
+	resp, err := victim.ListTickets(ctx, lineageId, &api.GetTicketsParams{
+		State:    &state,
+		MinNonce: &minNonce,
+		MaxNonce: &maxNonce,
+	})
+	if err != nil {
+		t.Fatalf("can not list tickets %s", err)
+	}
+
+	extIds = nil
+	for _, lease := range *resp.Leases {
+		extIds = append(extIds, lease.ExtId)
+	}
+
+	if !reflect.DeepEqual(extIds, []string{"tx3", "tx4"}) {
+		t.Errorf("expected leased tickets [tx3 tx4], got %v", extIds)
+	}
+
+	leasedAfter := time.Now().Add(time.Hour)
+	resp, err = victim.ListTickets(ctx, lineageId, &api.GetTicketsParams{LeasedAfter: &leasedAfter})
+	if err != nil {
+		t.Fatalf("can not list tickets %s", err)
+	}
+
+	if len(*resp.Leases) != 0 {
+		t.Errorf("expected no tickets leased in the future, got %d", len(*resp.Leases))
+	}
+}
+
+func TestServicer_ListTickets_Transferred(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2"}})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	_, err = victim.TransferTicket(ctx, lineageId, "tx1", &api.TicketTransferRequest{ExtId: "tx1-speedup"})
+	if err != nil {
+		t.Fatalf("can not transfer ticket %s", err)
+	}
+
+	limit := 1
+	params := &api.GetTicketsParams{Limit: &limit}
+
+	var extIds []string
+	for page := 0; page < 10; page++ {
+		resp, err := victim.ListTickets(ctx, lineageId, params)
+		if err != nil {
+			t.Fatalf("can not list tickets %s", err)
+		}
+
+		for _, lease := range *resp.Leases {
+			extIds = append(extIds, lease.ExtId)
+		}
+
+		if resp.NextCursor == nil {
+			break
+		}
+		params.Cursor = resp.NextCursor
+	}
+
+	expected := []string{"tx1", "tx1-speedup", "tx2"}
+	if !reflect.DeepEqual(extIds, expected) {
+		t.Errorf("expected tickets %v, got %v", expected, extIds)
+	}
+}
+
+func TestServicer_ListTickets_NoSuchLineage(t *testing.T) {
+	aUuid, _ := uuid.NewUUID()
+
+	_, err := victim.ListTickets(ctx, aUuid.String(), &api.GetTicketsParams{})
+	if err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_ListTickets_InvalidCursor(t *testing.T) {
+	lineageId := createLineage(t)
+
+	cursor := "not-a-cursor"
+	_, err := victim.ListTickets(ctx, lineageId, &api.GetTicketsParams{Cursor: &cursor})
+	if err != ticket.ErrInvalidRequest {
+		t.Errorf("expected ErrInvalidRequest, got %s", err)
+	}
+}
+
+func TestServicer_GetNonce(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1", "tx2", "tx3"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx3"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	cases := []struct {
+		nonce  int64
+		status api.NonceGetResponseStatus
+		extId  string
+	}{
+		{0, api.NonceGetResponseStatusLeased, "tx1"},
+		{1, api.NonceGetResponseStatusClosed, "tx2"},
+		{2, api.NonceGetResponseStatusReleased, ""},
+		{3, api.NonceGetResponseStatusNeverIssued, ""},
+	}
+
+	for _, c := range cases {
+		resp, err := victim.GetNonce(ctx, lineageId, c.nonce)
+		if err != nil {
+			t.Fatalf("can not get nonce %d %s", c.nonce, err)
+		}
+
+		if resp.Status != c.status {
+			t.Errorf("nonce %d expected to be %s, got %s", c.nonce, c.status, resp.Status)
+		}
+
+		if c.extId != "" && (resp.ExtId == nil || *resp.ExtId != c.extId) {
+			t.Errorf("nonce %d expected to be held by %s, got %v", c.nonce, c.extId, resp.ExtId)
+		}
+
+		if c.extId == "" && resp.ExtId != nil {
+			t.Errorf("nonce %d expected not to be held by a ticket, got %s", c.nonce, *resp.ExtId)
+		}
+	}
+}
+
+func TestServicer_GetNonce_Unused(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+
+	startLeasingFrom := 5
+	resp, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+		StartLeasingFrom:    &startLeasingFrom,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	nonceResp, err := victim.GetNonce(ctx, resp.Id, 2)
+	if err != nil {
+		t.Fatalf("can not get nonce %s", err)
+	}
+
+	if nonceResp.Status != api.NonceGetResponseStatusUnused {
+		t.Errorf("expected nonce to be unused, got %s", nonceResp.Status)
+	}
+}
+
+func TestServicer_GetNonce_NoSuchLineage(t *testing.T) {
+	aUuid, _ := uuid.NewUUID()
+
+	_, err := victim.GetNonce(ctx, aUuid.String(), 0)
+	if err != ticket.ErrNoSuchLineage {
+		t.Errorf("expected ErrNoSuchLineage, got %s", err)
+	}
+}
+
+func TestServicer_GetTicketHistory(t *testing.T) {
+	lineageId := createLineage(t)
+
+	aliceCtx := ticket.WithPrincipal(ticket.WithActor(ctx, "alice"), "key:0123456789ab")
+	_, err := victim.LeaseTicket(aliceCtx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx1"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ticket.WithActor(ctx, "bob"), lineageId, "tx1"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds: []string{"tx2"},
+	})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	resp, err := victim.GetTicketHistory(ctx, lineageId, "tx1")
+	if err != nil {
+		t.Fatalf("can not get ticket history %s", err)
+	}
+
+	if len(resp.Entries) != 2 {
+		t.Fatalf("expected 2 history entries, got %d", len(resp.Entries))
+	}
+
+	if resp.Entries[0].Action != api.TicketHistoryEntryActionLeased || *resp.Entries[0].Actor != "alice" ||
+		resp.Entries[0].Principal == nil || *resp.Entries[0].Principal != "key:0123456789ab" {
+
+		t.Errorf("expected ticket to be leased by alice with the key of alice, got %v", resp.Entries[0])
+	}
+
+	if resp.Entries[1].Action != api.TicketHistoryEntryActionReleased || *resp.Entries[1].Actor != "bob" ||
+		resp.Entries[1].Principal != nil {
+
+		t.Errorf("expected ticket to be released by bob without a key, got %v", resp.Entries[1])
+	}
+
+	resp, err = victim.GetTicketHistory(ctx, lineageId, "tx2")
+	if err != nil {
+		t.Fatalf("can not get ticket history %s", err)
+	}
+
+	expectedActions := []api.TicketHistoryEntryAction{
+		api.TicketHistoryEntryActionReLeased,
+		api.TicketHistoryEntryActionClosed,
+	}
+	if len(resp.Entries) != len(expectedActions) {
+		t.Fatalf("expected %d history entries, got %d", len(expectedActions), len(resp.Entries))
+	}
+
+	for i, entry := range resp.Entries {
+		if entry.Action != expectedActions[i] {
+			t.Errorf("expected action %s, got %s", expectedActions[i], entry.Action)
+		}
+
+		if entry.Nonce != 0 {
+			t.Errorf("expected nonce 0 to be re-leased, got %d", entry.Nonce)
+		}
+	}
+}
+
+func TestServicer_GetTicketHistory_NoSuchTicket(t *testing.T) {
+	lineageId := createLineage(t)
+
+	_, err := victim.GetTicketHistory(ctx, lineageId, "tx1")
+	if err != ticket.ErrNoSuchTicket {
+		t.Errorf("expected ErrNoSuchTicket, got %s", err)
+	}
+}
+
 func TestServicer_LeaseTicketsInBulk(t *testing.T) {
 	lineageId := createLineage(t)
 
@@ -578,6 +2217,111 @@ func TestServicer_LeaseTicketsInBulk(t *testing.T) {
 	}
 }
 
+func TestServicer_LeaseTicketsInBulk_Contiguous(t *testing.T) {
+	lineageId := createLineage(t)
+	contiguous := true
+
+	_, err := victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2", "tx3"}})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineageId, "tx2"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	request := &api.TicketLeaseRequest{ExtIds: []string{"tx4", "tx5"}, Contiguous: &contiguous}
+	_, err = victim.LeaseTicket(ctx, lineageId, request)
+	if err != ticket.ErrNoContiguousRange {
+		t.Errorf("expected ErrNoContiguousRange while a released nonce is pending, got %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{ExtIds: []string{"tx2"}})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := victim.LeaseTicket(ctx, lineageId, request)
+		if err != nil {
+			t.Fatalf("can not lease contiguous tickets %s", err)
+		}
+
+		for j, lease := range *resp.Leases {
+			if lease.Nonce != 3+j {
+				t.Errorf("ticket with extId=%s expected to have nonce=%d, got=%d", lease.ExtId, 3+j, lease.Nonce)
+			}
+		}
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineageId, &api.TicketLeaseRequest{
+		ExtIds:     []string{"tx5", "tx6"},
+		Contiguous: &contiguous,
+	})
+	if err != ticket.ErrNoContiguousRange {
+		t.Errorf("expected ErrNoContiguousRange for partially leased ext ids, got %s", err)
+	}
+}
+
+func TestServicer_LeaseTicketsInBulk_Partial(t *testing.T) {
+	extIdUUID, _ := uuid.NewUUID()
+	lineage, err := victim.CreateLineage(ctx, &api.LineageCreationRequest{
+		ExtId:               fmt.Sprintf("test-%s", extIdUUID.String()),
+		MaxLeasedNonceCount: 3,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	_, err = victim.LeaseTicket(ctx, lineage.Id, &api.TicketLeaseRequest{ExtIds: []string{"tx1", "tx2"}})
+	if err != nil {
+		t.Fatalf("can not lease tickets %s", err)
+	}
+
+	if err := victim.CloseTicket(ctx, lineage.Id, "tx1"); err != nil {
+		t.Fatalf("can not close ticket %s", err)
+	}
+
+	if err := victim.ReleaseTicket(ctx, lineage.Id, "tx2"); err != nil {
+		t.Fatalf("can not release ticket %s", err)
+	}
+
+	partial := true
+	resp, err := victim.LeaseTicket(ctx, lineage.Id, &api.TicketLeaseRequest{
+		ExtIds:  []string{"tx1", "tx2", "tx3", "tx4", "tx5"},
+		Partial: &partial,
+	})
+	if err != nil {
+		t.Fatalf("can not lease tickets partially %s", err)
+	}
+
+	var leased []string
+	for _, lease := range *resp.Leases {
+		leased = append(leased, lease.ExtId)
+	}
+	if !reflect.DeepEqual(leased, []string{"tx2", "tx3", "tx4"}) {
+		t.Errorf("expected tx2, tx3 and tx4 to be leased, got %v", leased)
+	}
+
+	expected := []api.TicketLeaseRejection{
+		{ExtId: "tx1", Code: api.TicketLeaseRejectionCodeNotLeasable},
+		{ExtId: "tx5", Code: api.TicketLeaseRejectionCodeTooManyLeasedTickets},
+	}
+	if !reflect.DeepEqual(*resp.Rejected, expected) {
+		t.Errorf("expected rejections %v, got %v", expected, *resp.Rejected)
+	}
+
+	contiguous := true
+	_, err = victim.LeaseTicket(ctx, lineage.Id, &api.TicketLeaseRequest{
+		ExtIds:     []string{"tx6"},
+		Partial:    &partial,
+		Contiguous: &contiguous,
+	})
+	if err != ticket.ErrInvalidRequest {
+		t.Errorf("expected ErrInvalidRequest for a partial contiguous lease, got %s", err)
+	}
+}
+
 func TestServicer_LeaseTicketsInBulk_Idempotency(t *testing.T) {
 	lineageId := createLineage(t)
 
@@ -0,0 +1,318 @@
+package psql_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+	api "github.com/welthee/dinonce/v2/internal/api/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"github.com/welthee/dinonce/v2/internal/webhook"
+)
+
+const webhookClaimLease = time.Minute
+
+// createWebhook registers a webhook for the lineages of the tenant namespace with a prefix of its own, so that the
+// lineages of other tests do not queue deliveries to it. It is deleted with its deliveries once the test ends.
+func createWebhook(t *testing.T) (context.Context, *admin.Webhook, string) {
+	wCtx := ticket.WithNamespace(ctx, namespaceTenant)
+	prefix := "webhook-" + uuid.NewString() + "-"
+
+	w, err := webhookVictim.CreateWebhook(wCtx, &admin.WebhookCreationRequest{
+		Url:         "https://example.com/hook",
+		ExtIdPrefix: &prefix,
+	})
+	if err != nil {
+		t.Fatalf("can not create webhook %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := webhookVictim.DeleteWebhook(wCtx, w.Id); err != nil {
+			t.Errorf("can not delete webhook %s", err)
+		}
+	})
+
+	return wCtx, w, prefix
+}
+
+// createWebhookLineage creates a lineage whose lineage_created event is queued for delivery to the webhook.
+func createWebhookLineage(t *testing.T, wCtx context.Context, prefix string) string {
+	resp, err := victim.CreateLineage(wCtx, &api.LineageCreationRequest{
+		ExtId:               prefix + uuid.NewString(),
+		MaxLeasedNonceCount: maxLeasedNonceCount,
+	})
+	if err != nil {
+		t.Fatalf("can not create lineage %s", err)
+	}
+
+	return resp.Id
+}
+
+// claimWebhookDeliveries claims the due deliveries, returning those to the webhook.
+func claimWebhookDeliveries(t *testing.T, webhookId string) []webhook.Delivery {
+	deliveries, err := webhookVictim.ClaimDeliveries(ctx, 1000, webhookClaimLease)
+	if err != nil {
+		t.Fatalf("can not claim deliveries %s", err)
+	}
+
+	return webhookDeliveries(deliveries, webhookId)
+}
+
+func webhookDeliveries(deliveries []webhook.Delivery, webhookId string) []webhook.Delivery {
+	var own []webhook.Delivery
+	for _, d := range deliveries {
+		if d.WebhookId == webhookId {
+			own = append(own, d)
+		}
+	}
+
+	return own
+}
+
+func TestWebhookStore_ClaimDeliveries(t *testing.T) {
+	wCtx, w, prefix := createWebhook(t)
+	lineageId := createWebhookLineage(t, wCtx, prefix)
+
+	deliveries := claimWebhookDeliveries(t, w.Id)
+	if len(deliveries) != 1 {
+		t.Fatalf("expected a single delivery, got %d", len(deliveries))
+	}
+
+	d := deliveries[0]
+	if d.Url != w.Url || d.Secret != *w.Secret || d.Attempts != 0 || d.Event.LineageId != lineageId ||
+		d.Event.Type != admin.LineageEventTypeLineageCreated {
+
+		t.Errorf("expected first delivery of the lineage_created event of %s, got %+v", lineageId, d)
+	}
+
+	if again := claimWebhookDeliveries(t, w.Id); len(again) != 0 {
+		t.Errorf("expected claimed delivery to be hidden from other claims, got %d", len(again))
+	}
+}
+
+func TestWebhookStore_ClaimDeliveries_Concurrent(t *testing.T) {
+	wCtx, w, prefix := createWebhook(t)
+
+	const lineageCount = 10
+	for i := 0; i < lineageCount; i++ {
+		createWebhookLineage(t, wCtx, prefix)
+	}
+
+	// two dispatchers claim at once, each delivery must be claimed by exactly one of them
+	const dispatcherCount = 2
+	claimed := make([][]webhook.Delivery, dispatcherCount)
+	errs := make([]error, dispatcherCount)
+
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < dispatcherCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			deliveries, err := webhookVictim.ClaimDeliveries(ctx, 1000, webhookClaimLease)
+			claimed[i], errs[i] = webhookDeliveries(deliveries, w.Id), err
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	var ids []int64
+	for i := range claimed {
+		if errs[i] != nil {
+			t.Fatalf("can not claim deliveries %s", errs[i])
+		}
+
+		for _, d := range claimed[i] {
+			ids = append(ids, d.Id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for i := 1; i < len(ids); i++ {
+		if ids[i] == ids[i-1] {
+			t.Errorf("expected delivery %d to be claimed once", ids[i])
+		}
+	}
+
+	if len(ids) != lineageCount {
+		t.Errorf("expected %d deliveries to be claimed, got %d", lineageCount, len(ids))
+	}
+}
+
+func TestWebhookStore_FailDelivery_Retry(t *testing.T) {
+	wCtx, w, prefix := createWebhook(t)
+	createWebhookLineage(t, wCtx, prefix)
+
+	d := claimWebhookDeliveries(t, w.Id)[0]
+
+	nextAttemptAt := time.Now().Add(time.Hour)
+	if err := webhookVictim.FailDelivery(ctx, d.Id, 0, "timeout", &nextAttemptAt); err != nil {
+		t.Fatalf("can not fail delivery %s", err)
+	}
+
+	if deliveries := claimWebhookDeliveries(t, w.Id); len(deliveries) != 0 {
+		t.Errorf("expected delivery not to be due before its next attempt, got %d", len(deliveries))
+	}
+
+	deadLetters, err := webhookVictim.ListDeadLetters(wCtx, w.Id)
+	if err != nil {
+		t.Fatalf("can not list dead letters %s", err)
+	}
+	if len(deadLetters.Deliveries) != 0 {
+		t.Errorf("expected delivery to be retried instead of dead, got %d dead letters", len(deadLetters.Deliveries))
+	}
+
+	// the retry is rescheduled to be due now
+	nextAttemptAt = time.Now().Add(-time.Second)
+	if err := webhookVictim.FailDelivery(ctx, d.Id, 0, "timeout", &nextAttemptAt); err != nil {
+		t.Fatalf("can not fail delivery %s", err)
+	}
+
+	deliveries := claimWebhookDeliveries(t, w.Id)
+	if len(deliveries) != 1 || deliveries[0].Id != d.Id || deliveries[0].Attempts != 2 {
+		t.Errorf("expected delivery %d to be due after 2 attempts, got %+v", d.Id, deliveries)
+	}
+}
+
+func TestWebhookStore_DeadLetters_Replay(t *testing.T) {
+	wCtx, w, prefix := createWebhook(t)
+	lineageId := createWebhookLineage(t, wCtx, prefix)
+
+	d := claimWebhookDeliveries(t, w.Id)[0]
+
+	if err := webhookVictim.FailDelivery(ctx, d.Id, 500, "internal server error", nil); err != nil {
+		t.Fatalf("can not fail delivery %s", err)
+	}
+
+	deadLetters, err := webhookVictim.ListDeadLetters(wCtx, w.Id)
+	if err != nil {
+		t.Fatalf("can not list dead letters %s", err)
+	}
+
+	if len(deadLetters.Deliveries) != 1 {
+		t.Fatalf("expected a single dead letter, got %d", len(deadLetters.Deliveries))
+	}
+
+	dead := deadLetters.Deliveries[0]
+	if dead.Id != d.Id || dead.Attempts != 1 || dead.LastStatusCode == nil || *dead.LastStatusCode != 500 ||
+		dead.LastError == nil || *dead.LastError != "internal server error" || dead.Event.LineageId != lineageId {
+
+		t.Errorf("expected dead letter of delivery %d after a 500, got %+v", d.Id, dead)
+	}
+
+	if deliveries := claimWebhookDeliveries(t, w.Id); len(deliveries) != 0 {
+		t.Errorf("expected dead letter not to be delivered, got %d deliveries", len(deliveries))
+	}
+
+	replay, err := webhookVictim.ReplayDeadLetters(wCtx, w.Id, &admin.WebhookReplayRequest{
+		DeliveryIds: &[]int64{d.Id},
+	})
+	if err != nil {
+		t.Fatalf("can not replay dead letters %s", err)
+	}
+	if replay.Replayed != 1 {
+		t.Errorf("expected a single dead letter to be replayed, got %d", replay.Replayed)
+	}
+
+	deadLetters, err = webhookVictim.ListDeadLetters(wCtx, w.Id)
+	if err != nil {
+		t.Fatalf("can not list dead letters %s", err)
+	}
+	if len(deadLetters.Deliveries) != 0 {
+		t.Errorf("expected no dead letters after the replay, got %d", len(deadLetters.Deliveries))
+	}
+
+	deliveries := claimWebhookDeliveries(t, w.Id)
+	if len(deliveries) != 1 || deliveries[0].Id != d.Id || deliveries[0].Attempts != 0 {
+		t.Errorf("expected replayed delivery %d to be due from scratch, got %+v", d.Id, deliveries)
+	}
+
+	// only dead letters are replayed, the claimed delivery is not
+	replay, err = webhookVictim.ReplayDeadLetters(wCtx, w.Id, &admin.WebhookReplayRequest{})
+	if err != nil {
+		t.Fatalf("can not replay dead letters %s", err)
+	}
+	if replay.Replayed != 0 {
+		t.Errorf("expected no dead letters to be replayed, got %d", replay.Replayed)
+	}
+}
+
+func TestWebhookStore_PurgeDeliveries(t *testing.T) {
+	wCtx, w, prefix := createWebhook(t)
+	createWebhookLineage(t, wCtx, prefix)
+	createWebhookLineage(t, wCtx, prefix)
+
+	deliveries := claimWebhookDeliveries(t, w.Id)
+	if len(deliveries) != 2 {
+		t.Fatalf("expected 2 deliveries, got %d", len(deliveries))
+	}
+
+	delivered, dead := deliveries[0], deliveries[1]
+	if err := webhookVictim.CompleteDelivery(ctx, delivered.Id, 204); err != nil {
+		t.Fatalf("can not complete delivery %s", err)
+	}
+	if err := webhookVictim.FailDelivery(ctx, dead.Id, 410, "gone", nil); err != nil {
+		t.Fatalf("can not fail delivery %s", err)
+	}
+
+	if err := webhookVictim.PurgeDeliveries(ctx, time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("can not purge deliveries %s", err)
+	}
+	if !deliveryExists(t, delivered.Id) {
+		t.Errorf("expected delivery %d delivered after the purge horizon to be kept", delivered.Id)
+	}
+
+	if err := webhookVictim.PurgeDeliveries(ctx, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("can not purge deliveries %s", err)
+	}
+	if deliveryExists(t, delivered.Id) {
+		t.Errorf("expected delivered delivery %d to be purged", delivered.Id)
+	}
+	if !deliveryExists(t, dead.Id) {
+		t.Errorf("expected dead letter %d to be kept", dead.Id)
+	}
+}
+
+func TestWebhookStore_Scope(t *testing.T) {
+	_, w, _ := createWebhook(t)
+	otherCtx := ticket.WithNamespace(ctx, namespaceLimitedTenant)
+
+	if _, err := webhookVictim.GetWebhook(otherCtx, w.Id); err != webhook.ErrNoSuchWebhook {
+		t.Errorf("expected ErrNoSuchWebhook, got %v", err)
+	}
+
+	if _, err := webhookVictim.ListDeadLetters(otherCtx, w.Id); err != webhook.ErrNoSuchWebhook {
+		t.Errorf("expected ErrNoSuchWebhook, got %v", err)
+	}
+
+	if _, err := webhookVictim.ReplayDeadLetters(otherCtx, w.Id, &admin.WebhookReplayRequest{}); err !=
+		webhook.ErrNoSuchWebhook {
+
+		t.Errorf("expected ErrNoSuchWebhook, got %v", err)
+	}
+
+	if err := webhookVictim.DeleteWebhook(otherCtx, w.Id); err != webhook.ErrNoSuchWebhook {
+		t.Errorf("expected ErrNoSuchWebhook, got %v", err)
+	}
+
+	if _, err := webhookVictim.GetWebhook(otherCtx, "not-a-uuid"); err != ticket.ErrInvalidRequest {
+		t.Errorf("expected ErrInvalidRequest, got %v", err)
+	}
+}
+
+func deliveryExists(t *testing.T, deliveryId int64) bool {
+	var exists bool
+	err := testDb.QueryRowContext(ctx, `select exists(select 1 from webhook_deliveries where id = $1)`,
+		deliveryId).Scan(&exists)
+	if err != nil {
+		t.Fatalf("can not look up delivery %s", err)
+	}
+
+	return exists
+}
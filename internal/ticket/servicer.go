@@ -3,6 +3,7 @@ package ticket
 import (
 	"context"
 	"errors"
+	"time"
 
 	api "github.com/welthee/dinonce/v2/internal/api/generated"
 )
@@ -13,14 +14,53 @@ var (
 	ErrInvalidRequest            = errors.New("invalid request")
 	ErrTooManyLeasedTickets      = errors.New("too many leased tickets")
 	ErrTooManyConcurrentRequests = errors.New("too many concurrent requests")
+	ErrNoSuchNamespace           = errors.New("no such namespace")
+	ErrNamespaceLimitExceeded    = errors.New("namespace limit exceeded")
+	ErrLineageConflict           = errors.New("lineage already exists with a different configuration")
+	ErrNoContiguousRange         = errors.New("no contiguous range of nonces can be leased")
 )
 
+// Servicer manages lineages and their tickets. Every call is scoped to the namespace carried by its context,
+// see WithNamespace.
 type Servicer interface {
 	CreateLineage(ctx context.Context, request *api.LineageCreationRequest) (*api.LineageCreationResponse, error)
 	GetLineage(ctx context.Context, extId string) (*api.LineageGetResponse, error)
+	GetLineageById(ctx context.Context, lineageId string) (*api.LineageGetResponse, error)
+	GetLineageByAddress(ctx context.Context, chainId int64, address string) (*api.LineageGetResponse, error)
+	UpdateLineage(ctx context.Context, lineageId string, request *api.LineageUpdateRequest) (*api.LineageGetResponse, error)
+	ListLineages(ctx context.Context, params *api.ListLineagesParams) (*api.LineageListResponse, error)
+	GetLineageStats(ctx context.Context, params *api.GetLineageStatsParams) (*api.LineageStatsResponse, error)
+	CloneLineage(ctx context.Context, lineageId string, request *api.LineageCloneRequest) (*api.LineageGetResponse, error)
+	GetLineageVersion(ctx context.Context, lineageId string) (int64, error)
+	ListLineageEvents(ctx context.Context, lineageId string, params *api.ListLineageEventsParams) (*api.LineageEventListResponse, error)
+	GetLineageEventSeq(ctx context.Context, lineageId string) (int64, error)
+
+	// GetLineagesEventCursor returns the cursor of the events recorded from now on. ListLineagesEvents returns the
+	// events after the cursor of the lineages matching params, and the cursor to continue from, which moves past
+	// the events of the other lineages as well.
+	GetLineagesEventCursor(ctx context.Context) (EventCursor, error)
+	ListLineagesEvents(ctx context.Context, params *api.StreamLineagesEventsParams, after EventCursor, limit int) (
+		[]CursoredLineageEvent, EventCursor, error)
+
+	// SubscribeLineageEvents subscribes to the events of the lineage, or of every lineage of the namespace if
+	// lineageId is empty, so that streams read events only once they were woken.
+	SubscribeLineageEvents(ctx context.Context, lineageId string) *EventSubscription
+
 	LeaseTicket(ctx context.Context, lineageId string, request *api.TicketLeaseRequest) (*api.TicketLeaseResponse, error)
 	GetTicket(ctx context.Context, lineageId string, ticketExtId string) (*api.TicketLeaseResponse, error)
 	ReleaseTicket(ctx context.Context, lineageId string, ticketExtId string) error
 	CloseTicket(ctx context.Context, lineageId string, ticketExtId string) error
-	GetTickets(ctx context.Context, lineageId string, ticketExtIds []string) (*api.TicketLeaseResponse, error)
+	TransferTicket(ctx context.Context, lineageId string, ticketExtId string, request *api.TicketTransferRequest) (*api.TicketLeaseResponse, error)
+	UpdateTickets(ctx context.Context, lineageId string, request *api.TicketBulkUpdateRequest) (*api.TicketBulkUpdateResponse, error)
+	GetTickets(ctx context.Context, lineageId string, ticketExtIds []string, allOrNothing bool) (*api.TicketLeaseResponse, error)
+	GetTicketHistory(ctx context.Context, lineageId string, ticketExtId string) (*api.TicketHistoryResponse, error)
+	GetNonce(ctx context.Context, lineageId string, nonce int64) (*api.NonceGetResponse, error)
+	ListTickets(ctx context.Context, lineageId string, params *api.GetTicketsParams) (*api.TicketLeaseResponse, error)
+
+	// ReserveIdempotencyKey reserves the key for a request with the given fingerprint, or returns the response
+	// stored for it by an earlier request. CompleteIdempotencyKey stores the response of the reserving request
+	// and ReleaseIdempotencyKey gives up the reservation of a request which failed without side effects.
+	ReserveIdempotencyKey(ctx context.Context, lineageId string, key string, fingerprint string) (*IdempotentResponse, error)
+	CompleteIdempotencyKey(ctx context.Context, lineageId string, key string, resp *IdempotentResponse, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, lineageId string, key string) error
 }
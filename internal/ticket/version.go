@@ -0,0 +1,23 @@
+package ticket
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrLineageVersionMismatch = errors.New("lineage version does not match the expected version")
+
+type expectedVersionContextKey struct{}
+
+// WithExpectedVersion returns a copy of ctx making ticket changes of servicer calls fail with
+// ErrLineageVersionMismatch unless the lineage is still at the given version.
+func WithExpectedVersion(ctx context.Context, version int64) context.Context {
+	return context.WithValue(ctx, expectedVersionContextKey{}, version)
+}
+
+// ExpectedVersionFromContext returns the lineage version ticket changes are conditional on, if any.
+func ExpectedVersionFromContext(ctx context.Context) (int64, bool) {
+	version, ok := ctx.Value(expectedVersionContextKey{}).(int64)
+
+	return version, ok
+}
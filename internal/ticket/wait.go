@@ -0,0 +1,152 @@
+package ticket
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MaxWait is the longest a lease request may wait for a free slot of its lineage.
+const MaxWait = 30 * time.Second
+
+type waitContextKey struct{}
+
+// WithWait returns a copy of ctx making leases of servicer calls wait up to timeout for a free slot of the lineage
+// instead of failing with ErrTooManyLeasedTickets.
+func WithWait(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, waitContextKey{}, timeout)
+}
+
+// WaitFromContext returns how long leases wait for a free slot of the lineage, if at all.
+func WaitFromContext(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(waitContextKey{}).(time.Duration)
+
+	return timeout, ok && timeout > 0
+}
+
+// Waiters queues the leases waiting for a free slot of their lineage and serves them in FIFO order. Only the first
+// waiter of a lineage retries its lease, when woken by Notify or every poll interval, which also picks up slots
+// freed by other instances.
+type Waiters struct {
+	poll time.Duration
+
+	mu     sync.Mutex
+	queues map[string][]*waiter
+}
+
+type waiter struct {
+	wake chan struct{}
+}
+
+// NewWaiters creates waiters whose first waiter of a lineage retries its lease at least every poll interval.
+func NewWaiters(poll time.Duration) *Waiters {
+	return &Waiters{
+		poll:   poll,
+		queues: make(map[string][]*waiter),
+	}
+}
+
+// Notify wakes the first waiter of the lineage, to be called once a slot of the lineage was freed.
+func (w *Waiters) Notify(lineageId string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if queue := w.queues[lineageId]; len(queue) > 0 {
+		queue[0].signal()
+	}
+}
+
+// Wait calls lease until it does not fail with ErrTooManyLeasedTickets, or until timeout expires in which case
+// ErrTooManyLeasedTickets is returned. Leases of a lineage which already has waiters queue up behind them without
+// trying first.
+func (w *Waiters) Wait(ctx context.Context, lineageId string, timeout time.Duration, lease func() error) error {
+	if !w.queued(lineageId) {
+		if err := lease(); err != ErrTooManyLeasedTickets {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	me := w.enqueue(lineageId)
+	defer w.dequeue(lineageId, me)
+
+	ticker := time.NewTicker(w.poll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ErrTooManyLeasedTickets
+		case <-me.wake:
+		case <-ticker.C:
+			if !w.first(lineageId, me) {
+				continue
+			}
+		}
+
+		if err := lease(); err != ErrTooManyLeasedTickets {
+			return err
+		}
+	}
+}
+
+func (w *Waiters) queued(lineageId string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.queues[lineageId]) > 0
+}
+
+func (w *Waiters) first(lineageId string, me *waiter) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	queue := w.queues[lineageId]
+
+	return len(queue) > 0 && queue[0] == me
+}
+
+func (w *Waiters) enqueue(lineageId string) *waiter {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	me := &waiter{wake: make(chan struct{}, 1)}
+	w.queues[lineageId] = append(w.queues[lineageId], me)
+
+	return me
+}
+
+// dequeue removes the waiter. If it was the first waiter, the next one is woken to lease a slot which may still be
+// free, and to take over retrying.
+func (w *Waiters) dequeue(lineageId string, me *waiter) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	queue := w.queues[lineageId]
+	wasFirst := len(queue) > 0 && queue[0] == me
+	for i, other := range queue {
+		if other == me {
+			queue = append(queue[:i], queue[i+1:]...)
+			break
+		}
+	}
+
+	if len(queue) == 0 {
+		delete(w.queues, lineageId)
+		return
+	}
+
+	w.queues[lineageId] = queue
+	if wasFirst {
+		queue[0].signal()
+	}
+}
+
+func (w *waiter) signal() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
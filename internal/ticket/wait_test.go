@@ -0,0 +1,90 @@
+package ticket_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/welthee/dinonce/v2/internal/ticket"
+)
+
+func TestWaiters_Wait_Immediate(t *testing.T) {
+	waiters := ticket.NewWaiters(time.Hour)
+
+	calls := 0
+	err := waiters.Wait(context.Background(), "lineage", time.Second, func() error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("can not wait %s", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected a single lease, got %d", calls)
+	}
+}
+
+func TestWaiters_Wait_Timeout(t *testing.T) {
+	waiters := ticket.NewWaiters(time.Hour)
+
+	err := waiters.Wait(context.Background(), "lineage", 10*time.Millisecond, func() error {
+		return ticket.ErrTooManyLeasedTickets
+	})
+	if err != ticket.ErrTooManyLeasedTickets {
+		t.Errorf("expected %s, got %v", ticket.ErrTooManyLeasedTickets, err)
+	}
+}
+
+func TestWaiters_Wait_FIFO(t *testing.T) {
+	waiters := ticket.NewWaiters(time.Hour)
+
+	var mu sync.Mutex
+	free := 0
+	var leased []int
+	lease := func(i int) func() error {
+		return func() error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if free == 0 {
+				return ticket.ErrTooManyLeasedTickets
+			}
+			free--
+			leased = append(leased, i)
+
+			return nil
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := waiters.Wait(context.Background(), "lineage", time.Minute, lease(i)); err != nil {
+				t.Errorf("can not wait %s", err)
+			}
+		}(i)
+
+		// queue the waiters up one after the other
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	for i := 0; i < 3; i++ {
+		mu.Lock()
+		free++
+		mu.Unlock()
+		waiters.Notify("lineage")
+
+		time.Sleep(20 * time.Millisecond)
+	}
+	wg.Wait()
+
+	for i, l := range leased {
+		if l != i {
+			t.Fatalf("expected leases in order of arrival, got %v", leased)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+var ErrDestinationNotAllowed = errors.New("webhook destination is not allowed")
+
+// destinationGuard keeps deliveries away from the network of the service. It checks the address a connection is
+// made to, after the host of the webhook URL and of every redirect was resolved, so a webhook host resolving to a
+// private address is rejected as well.
+type destinationGuard struct {
+	allowed []*net.IPNet
+}
+
+// control is the net.Dialer Control function of the deliveries.
+func (g destinationGuard) control(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("%w: %s", ErrDestinationNotAllowed, host)
+	}
+
+	if !g.allows(ip) {
+		return fmt.Errorf("%w: %s", ErrDestinationNotAllowed, ip)
+	}
+
+	return nil
+}
+
+// allows reports whether deliveries may connect to ip. Loopback, private, link-local, unspecified and multicast
+// addresses are rejected unless they are in one of the allowed networks.
+func (g destinationGuard) allows(ip net.IP) bool {
+	for _, network := range g.allowed {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsUnspecified() && !ip.IsMulticast()
+}
+
+// ParseNetworks parses the CIDR notation of the networks deliveries may connect to despite being internal.
+func ParseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
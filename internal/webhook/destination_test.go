@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestDestinationGuard(t *testing.T) {
+	guard := destinationGuard{}
+
+	for _, address := range []string{"127.0.0.1:80", "[::1]:443", "10.1.2.3:80", "172.16.0.1:80", "192.168.1.1:80",
+		"169.254.169.254:80", "[fe80::1]:80", "0.0.0.0:80", "[::]:80", "[fd00::1]:80", "[::ffff:127.0.0.1]:80",
+		"224.0.0.1:80"} {
+
+		if err := guard.control("tcp", address, nil); !errors.Is(err, ErrDestinationNotAllowed) {
+			t.Errorf("expected %s to be rejected, got %v", address, err)
+		}
+	}
+
+	for _, address := range []string{"93.184.216.34:443", "[2606:2800:220:1:248:1893:25c8:1946]:443"} {
+		if err := guard.control("tcp", address, nil); err != nil {
+			t.Errorf("expected %s to be allowed, got %s", address, err)
+		}
+	}
+}
+
+func TestDestinationGuard_AllowedNetworks(t *testing.T) {
+	networks, err := ParseNetworks([]string{"10.1.0.0/16"})
+	if err != nil {
+		t.Fatalf("can not parse networks %s", err)
+	}
+	guard := destinationGuard{allowed: networks}
+
+	if !guard.allows(net.ParseIP("10.1.2.3")) {
+		t.Errorf("expected an address of an allowed network to be allowed")
+	}
+
+	if guard.allows(net.ParseIP("10.2.0.1")) {
+		t.Errorf("expected a private address outside the allowed networks to be rejected")
+	}
+}
+
+func TestParseNetworks_Invalid(t *testing.T) {
+	if _, err := ParseNetworks([]string{"10.0.0.1"}); err == nil {
+		t.Errorf("expected an address without prefix length to be invalid")
+	}
+}
@@ -0,0 +1,195 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+)
+
+// MaxDeliveryAttempts is the number of attempts after which a failing delivery becomes a dead letter.
+const MaxDeliveryAttempts = 10
+
+// Dispatching constants
+const (
+	dispatchBatchSize    = 50
+	dispatchPollInterval = time.Second
+	deliveryTimeout      = 10 * time.Second
+	deliveryLease        = time.Minute
+	retryBaseDelay       = 10 * time.Second
+	retryMaxDelay        = time.Hour
+	deliveredRetention   = 7 * 24 * time.Hour
+	purgeInterval        = time.Hour
+)
+
+const (
+	HeaderDeliveryId = "X-Dinonce-Delivery"
+	HeaderEventType  = "X-Dinonce-Event"
+)
+
+// Dispatcher POSTs the pending deliveries of a Store to their webhooks. Several dispatchers can share a store, every
+// delivery is claimed by a single one of them at a time.
+type Dispatcher struct {
+	store  Store
+	client *http.Client
+}
+
+// NewDispatcher returns a dispatcher which only delivers to public addresses and to the allowed networks. Deliveries
+// connect directly, the proxy of the environment is not used since the addresses it connects to can not be checked.
+func NewDispatcher(store Store, allowedNetworks ...*net.IPNet) *Dispatcher {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: destinationGuard{allowed: allowedNetworks}.control,
+	}
+
+	return &Dispatcher{
+		store: store,
+		client: &http.Client{
+			Timeout: deliveryTimeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				ForceAttemptHTTP2:   true,
+				TLSHandshakeTimeout: deliveryTimeout,
+			},
+		},
+	}
+}
+
+// Run dispatches deliveries until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(dispatchPollInterval)
+	defer ticker.Stop()
+
+	lastPurge := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for d.dispatch(ctx) == dispatchBatchSize {
+		}
+
+		if time.Since(lastPurge) >= purgeInterval {
+			if err := d.store.PurgeDeliveries(ctx, time.Now().Add(-deliveredRetention)); err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("can not purge webhook deliveries")
+			}
+			lastPurge = time.Now()
+		}
+	}
+}
+
+// dispatch sends a batch of due deliveries and returns its size.
+func (d *Dispatcher) dispatch(ctx context.Context) int {
+	deliveries, err := d.store.ClaimDeliveries(ctx, dispatchBatchSize, deliveryLease)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Ctx(ctx).Error().Err(err).Msg("can not claim webhook deliveries")
+		}
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(delivery *Delivery) {
+			defer wg.Done()
+			d.deliver(ctx, delivery)
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	return len(deliveries)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery *Delivery) {
+	statusCode, err := d.post(ctx, delivery)
+	if err == nil {
+		if err := d.store.CompleteDelivery(ctx, delivery.Id, statusCode); err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("deliveryId", delivery.Id).Msg("can not complete webhook delivery")
+		}
+		return
+	}
+
+	attempts := delivery.Attempts + 1
+
+	var nextAttemptAt *time.Time
+	if attempts < MaxDeliveryAttempts {
+		next := time.Now().Add(retryDelay(attempts))
+		nextAttemptAt = &next
+	}
+
+	log.Ctx(ctx).Info().
+		Err(err).
+		Int64("deliveryId", delivery.Id).
+		Str("webhookId", delivery.WebhookId).
+		Int("attempts", attempts).
+		Bool("deadLetter", nextAttemptAt == nil).
+		Msg("webhook delivery failed")
+
+	if err := d.store.FailDelivery(ctx, delivery.Id, statusCode, err.Error(), nextAttemptAt); err != nil {
+		log.Ctx(ctx).Error().Err(err).Int64("deliveryId", delivery.Id).Msg("can not fail webhook delivery")
+	}
+}
+
+// post sends the delivery and returns the status code of the response, zero if none was received.
+func (d *Dispatcher) post(ctx context.Context, delivery *Delivery) (int, error) {
+	body, err := json.Marshal(admin.WebhookPayload{
+		DeliveryId: delivery.Id,
+		WebhookId:  delivery.WebhookId,
+		Event:      delivery.Event,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "dinonce-webhook")
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, time.Now(), body))
+	req.Header.Set(HeaderDeliveryId, strconv.FormatInt(delivery.Id, 10))
+	req.Header.Set(HeaderEventType, string(delivery.Event.Type))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// retryDelay doubles the delay of every attempt up to retryMaxDelay, randomised by up to half of it so that the
+// retries of deliveries which failed together spread out.
+func retryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+)
+
+type outcome struct {
+	deliveryId    int64
+	statusCode    int
+	nextAttemptAt *time.Time
+	completed     bool
+}
+
+type fakeStore struct {
+	Store
+	mu         sync.Mutex
+	deliveries []Delivery
+	outcomes   []outcome
+}
+
+func (s *fakeStore) ClaimDeliveries(_ context.Context, limit int, _ time.Duration) ([]Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.deliveries) > limit {
+		claimed := s.deliveries[:limit]
+		s.deliveries = s.deliveries[limit:]
+		return claimed, nil
+	}
+
+	claimed := s.deliveries
+	s.deliveries = nil
+	return claimed, nil
+}
+
+func (s *fakeStore) CompleteDelivery(_ context.Context, deliveryId int64, statusCode int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.outcomes = append(s.outcomes, outcome{deliveryId: deliveryId, statusCode: statusCode, completed: true})
+	return nil
+}
+
+func (s *fakeStore) FailDelivery(_ context.Context, deliveryId int64, statusCode int, _ string,
+	nextAttemptAt *time.Time) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.outcomes = append(s.outcomes, outcome{deliveryId: deliveryId, statusCode: statusCode, nextAttemptAt: nextAttemptAt})
+	return nil
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := Verify("secret", r.Header.Get(HeaderSignature), body, time.Minute); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.Header.Get(HeaderDeliveryId) == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	event := admin.LineageEvent{LineageId: "lineage", Seq: 1, Type: admin.LineageEventTypeTicketReleased}
+	store := &fakeStore{deliveries: []Delivery{
+		{Id: 1, WebhookId: "webhook", Url: server.URL, Secret: "secret", Event: event},
+		{Id: 2, WebhookId: "webhook", Url: server.URL, Secret: "secret", Event: event},
+		{Id: 3, WebhookId: "webhook", Url: server.URL, Secret: "other", Event: event,
+			Attempts: MaxDeliveryAttempts - 1},
+	}}
+
+	if n := NewDispatcher(store, loopback(t)...).dispatch(context.Background()); n != 3 {
+		t.Fatalf("expected 3 deliveries to be dispatched, got %d", n)
+	}
+
+	outcomes := make(map[int64]outcome)
+	for _, o := range store.outcomes {
+		outcomes[o.deliveryId] = o
+	}
+
+	if o := outcomes[1]; !o.completed || o.statusCode != http.StatusNoContent {
+		t.Errorf("expected delivery 1 to be completed, got %+v", o)
+	}
+
+	if o := outcomes[2]; o.completed || o.statusCode != http.StatusInternalServerError || o.nextAttemptAt == nil {
+		t.Errorf("expected delivery 2 to be retried, got %+v", o)
+	}
+
+	if o := outcomes[3]; o.completed || o.statusCode != http.StatusUnauthorized || o.nextAttemptAt != nil {
+		t.Errorf("expected delivery 3 to become a dead letter, got %+v", o)
+	}
+}
+
+func TestDispatcher_Dispatch_InternalDestination(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	event := admin.LineageEvent{LineageId: "lineage", Seq: 1, Type: admin.LineageEventTypeTicketReleased}
+	store := &fakeStore{deliveries: []Delivery{
+		{Id: 1, WebhookId: "webhook", Url: server.URL, Secret: "secret", Event: event},
+	}}
+
+	NewDispatcher(store).dispatch(context.Background())
+
+	if requests != 0 {
+		t.Errorf("expected no request to reach the loopback webhook, got %d", requests)
+	}
+
+	if len(store.outcomes) != 1 || store.outcomes[0].completed || store.outcomes[0].nextAttemptAt == nil {
+		t.Errorf("expected delivery 1 to be retried, got %+v", store.outcomes)
+	}
+}
+
+func loopback(t *testing.T) []*net.IPNet {
+	networks, err := ParseNetworks([]string{"127.0.0.0/8", "::1/128"})
+	if err != nil {
+		t.Fatalf("can not parse networks %s", err)
+	}
+
+	return networks
+}
+
+func TestRetryDelay(t *testing.T) {
+	for attempt := 1; attempt < 64; attempt++ {
+		delay := retryDelay(attempt)
+
+		expected := time.Duration(math.Min(float64(retryBaseDelay)*math.Pow(2, float64(attempt-1)),
+			float64(retryMaxDelay)))
+
+		if delay < expected/2 || delay > expected {
+			t.Errorf("expected delay of attempt %d to be within [%s, %s], got %s", attempt, expected/2, expected,
+				delay)
+		}
+	}
+}
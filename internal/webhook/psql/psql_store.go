@@ -0,0 +1,380 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+	"github.com/welthee/dinonce/v2/internal/ticket"
+	"github.com/welthee/dinonce/v2/internal/webhook"
+)
+
+// deadLetterListLimit caps the number of dead letters listed for a webhook.
+const deadLetterListLimit = 1000
+
+const (
+	queryStringInsertWebhook = `insert into webhooks(id, namespace, url, secret, event_types, label_selector, 
+label_requirements, ext_id_prefix) values ($1, $2, $3, $4, $5, $6, $7, $8) returning created_at`
+
+	queryStringSelectWebhooks = `select id, url, event_types, label_selector, ext_id_prefix, created_at from webhooks 
+where namespace = $1 order by created_at, id`
+
+	queryStringSelectWebhook = `select id, url, event_types, label_selector, ext_id_prefix, created_at from webhooks 
+where namespace = $1 and id = $2`
+
+	queryStringDeleteWebhook = `delete from webhooks where namespace = $1 and id = $2`
+
+	queryStringSelectDeadLetters = `select d.id, d.attempts, d.last_status_code, d.last_error, d.created_at, 
+e.lineage_id, e.seq, e.type, e.ext_id, e.nonce, e.actor, e.created_at 
+from webhook_deliveries d join lineage_events e on e.lineage_id = d.lineage_id and e.seq = d.seq 
+where d.webhook_id = $1 and d.status = 'dead' order by d.id limit $2`
+
+	queryStringReplayDeadLetters = `update webhook_deliveries set status = 'pending', attempts = 0, 
+next_attempt_at = now(), last_status_code = null, last_error = null 
+where webhook_id = $1 and status = 'dead' and ($2::bigint[] is null or id = any($2))`
+
+	queryStringClaimDeliveries = `update webhook_deliveries d set next_attempt_at = now() + $2 * interval '1 millisecond' 
+from webhooks w, lineage_events e 
+where d.id in (select id from webhook_deliveries where status = 'pending' and next_attempt_at <= now() 
+order by next_attempt_at limit $1 for update skip locked) 
+and w.id = d.webhook_id and e.lineage_id = d.lineage_id and e.seq = d.seq 
+returning d.id, d.attempts, w.id, w.url, w.secret, e.lineage_id, e.seq, e.type, e.ext_id, e.nonce, e.actor, 
+e.created_at`
+
+	queryStringCompleteDelivery = `update webhook_deliveries set status = 'delivered', attempts = attempts + 1, 
+last_status_code = $2, last_error = null, delivered_at = now() where id = $1`
+
+	queryStringFailDelivery = `update webhook_deliveries 
+set status = case when $4::timestamptz is null then 'dead' else 'pending' end, attempts = attempts + 1, 
+last_status_code = $2, last_error = $3, next_attempt_at = coalesce($4, next_attempt_at) where id = $1`
+
+	queryStringPurgeDeliveries = `delete from webhook_deliveries where status = 'delivered' and delivered_at < $1`
+)
+
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) *Store {
+	var _ webhook.Store = &Store{}
+
+	return &Store{db: db}
+}
+
+func (s *Store) CreateWebhook(ctx context.Context, request *admin.WebhookCreationRequest) (*admin.Webhook, error) {
+	if err := webhook.ValidateURL(request.Url); err != nil {
+		log.Ctx(ctx).Info().Err(err).Str("url", request.Url).Msg("invalid webhook URL")
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	labelSelector := ""
+	if request.LabelSelector != nil {
+		labelSelector = *request.LabelSelector
+	}
+
+	selector, err := ticket.ParseLabelSelector(labelSelector)
+	if err != nil {
+		log.Ctx(ctx).Info().Err(err).Str("labelSelector", labelSelector).Msg("invalid label selector")
+		return nil, ticket.ErrInvalidRequest
+	}
+
+	requirements := make([]map[string]string, 0, len(selector))
+	for _, r := range selector {
+		requirements = append(requirements, map[string]string{
+			"key":      r.Key,
+			"operator": string(r.Operator),
+			"value":    r.Value,
+		})
+	}
+
+	requirementsJson, err := json.Marshal(requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret string
+	if request.Secret != nil {
+		secret = *request.Secret
+	} else if secret, err = webhook.NewSecret(); err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]admin.LineageEventType, 0)
+	if request.EventTypes != nil {
+		eventTypes = *request.EventTypes
+	}
+
+	extIdPrefix := ""
+	if request.ExtIdPrefix != nil {
+		extIdPrefix = *request.ExtIdPrefix
+	}
+
+	resp := &admin.Webhook{
+		Id:            uuid.NewString(),
+		Url:           request.Url,
+		Secret:        &secret,
+		EventTypes:    eventTypes,
+		LabelSelector: labelSelector,
+		ExtIdPrefix:   extIdPrefix,
+	}
+
+	err = s.db.QueryRowContext(ctx, queryStringInsertWebhook, resp.Id, ticket.NamespaceFromContext(ctx), resp.Url,
+		secret, pq.Array(eventTypes), labelSelector, requirementsJson, extIdPrefix).Scan(&resp.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("webhookId", resp.Id).
+		Str("url", resp.Url).
+		Msg("created webhook")
+
+	return resp, nil
+}
+
+func (s *Store) GetWebhook(ctx context.Context, webhookId string) (*admin.Webhook, error) {
+	resp, err := scanWebhook(s.db.QueryRowContext(ctx, queryStringSelectWebhook, ticket.NamespaceFromContext(ctx),
+		webhookId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, webhook.ErrNoSuchWebhook
+		}
+
+		return nil, mapError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *Store) ListWebhooks(ctx context.Context) (*admin.WebhookListResponse, error) {
+	rows, err := s.db.QueryContext(ctx, queryStringSelectWebhooks, ticket.NamespaceFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rowClose(ctx, rows)
+
+	webhooks := make([]admin.Webhook, 0)
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, *w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &admin.WebhookListResponse{Webhooks: webhooks}, nil
+}
+
+func (s *Store) DeleteWebhook(ctx context.Context, webhookId string) error {
+	res, err := s.db.ExecContext(ctx, queryStringDeleteWebhook, ticket.NamespaceFromContext(ctx), webhookId)
+	if err != nil {
+		return mapError(err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return webhook.ErrNoSuchWebhook
+	}
+
+	log.Ctx(ctx).Info().
+		Str("webhookId", webhookId).
+		Msg("deleted webhook")
+
+	return nil
+}
+
+func (s *Store) ListDeadLetters(ctx context.Context, webhookId string) (*admin.WebhookDeliveryListResponse, error) {
+	if _, err := s.GetWebhook(ctx, webhookId); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, queryStringSelectDeadLetters, webhookId, deadLetterListLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rowClose(ctx, rows)
+
+	deliveries := make([]admin.WebhookDelivery, 0)
+	for rows.Next() {
+		d := admin.WebhookDelivery{WebhookId: webhookId}
+		var lastStatusCode sql.NullInt64
+		var lastError sql.NullString
+
+		dest := []interface{}{&d.Id, &d.Attempts, &lastStatusCode, &lastError, &d.CreatedAt}
+		if err := scanEvent(rows, &d.Event, dest...); err != nil {
+			return nil, err
+		}
+
+		if lastStatusCode.Valid {
+			code := int(lastStatusCode.Int64)
+			d.LastStatusCode = &code
+		}
+		if lastError.Valid {
+			d.LastError = &lastError.String
+		}
+
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &admin.WebhookDeliveryListResponse{Deliveries: deliveries}, nil
+}
+
+func (s *Store) ReplayDeadLetters(ctx context.Context, webhookId string, request *admin.WebhookReplayRequest) (
+	*admin.WebhookReplayResponse, error) {
+
+	if _, err := s.GetWebhook(ctx, webhookId); err != nil {
+		return nil, err
+	}
+
+	var deliveryIds interface{}
+	if request.DeliveryIds != nil {
+		deliveryIds = pq.Array(*request.DeliveryIds)
+	}
+
+	res, err := s.db.ExecContext(ctx, queryStringReplayDeadLetters, webhookId, deliveryIds)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info().
+		Str("webhookId", webhookId).
+		Int64("count", n).
+		Msg("replayed dead letters")
+
+	return &admin.WebhookReplayResponse{Replayed: int(n)}, nil
+}
+
+func (s *Store) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	rows, err := s.db.QueryContext(ctx, queryStringClaimDeliveries, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rowClose(ctx, rows)
+
+	deliveries := make([]webhook.Delivery, 0)
+	for rows.Next() {
+		var d webhook.Delivery
+		if err := scanEvent(rows, &d.Event, &d.Id, &d.Attempts, &d.WebhookId, &d.Url, &d.Secret); err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (s *Store) CompleteDelivery(ctx context.Context, deliveryId int64, statusCode int) error {
+	_, err := s.db.ExecContext(ctx, queryStringCompleteDelivery, deliveryId, statusCode)
+
+	return err
+}
+
+func (s *Store) FailDelivery(ctx context.Context, deliveryId int64, statusCode int, lastError string,
+	nextAttemptAt *time.Time) error {
+
+	var code sql.NullInt64
+	if statusCode != 0 {
+		code = sql.NullInt64{Int64: int64(statusCode), Valid: true}
+	}
+
+	_, err := s.db.ExecContext(ctx, queryStringFailDelivery, deliveryId, code, lastError, nextAttemptAt)
+
+	return err
+}
+
+func (s *Store) PurgeDeliveries(ctx context.Context, deliveredBefore time.Time) error {
+	_, err := s.db.ExecContext(ctx, queryStringPurgeDeliveries, deliveredBefore)
+
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWebhook(row rowScanner) (*admin.Webhook, error) {
+	var resp admin.Webhook
+	var eventTypes []string
+
+	err := row.Scan(&resp.Id, &resp.Url, pq.Array(&eventTypes), &resp.LabelSelector, &resp.ExtIdPrefix,
+		&resp.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.EventTypes = make([]admin.LineageEventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		resp.EventTypes = append(resp.EventTypes, admin.LineageEventType(t))
+	}
+
+	return &resp, nil
+}
+
+// scanEvent scans the given columns followed by the columns of a lineage event.
+func scanEvent(row rowScanner, event *admin.LineageEvent, dest ...interface{}) error {
+	var eventType string
+	var extId sql.NullString
+	var nonce sql.NullInt64
+	var actor sql.NullString
+
+	dest = append(dest, &event.LineageId, &event.Seq, &eventType, &extId, &nonce, &actor, &event.CreatedAt)
+	if err := row.Scan(dest...); err != nil {
+		return err
+	}
+
+	event.Type = admin.LineageEventType(eventType)
+	if extId.Valid {
+		event.ExtId = &extId.String
+	}
+	if nonce.Valid {
+		event.Nonce = &nonce.Int64
+	}
+	if actor.Valid {
+		event.Actor = &actor.String
+	}
+
+	return nil
+}
+
+func mapError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		// 22P02 INVALID TEXT REPRESENTATION
+		case "22P02":
+			return ticket.ErrInvalidRequest
+		}
+	}
+
+	return err
+}
+
+func rowClose(ctx context.Context, rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("can not close rows")
+	}
+}
@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"deliveryId":1}`)
+	now := time.Now()
+
+	signature := Sign("secret", now, body)
+
+	if err := Verify("secret", signature, body, time.Minute); err != nil {
+		t.Errorf("expected signature to verify, got %s", err)
+	}
+
+	if err := Verify("other", signature, body, time.Minute); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature for another secret, got %v", err)
+	}
+
+	if err := Verify("secret", signature, []byte(`{"deliveryId":2}`), time.Minute); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature for another body, got %v", err)
+	}
+
+	old := Sign("secret", now.Add(-time.Hour), body)
+	if err := Verify("secret", old, body, time.Minute); err != ErrSignatureExpired {
+		t.Errorf("expected ErrSignatureExpired, got %v", err)
+	}
+
+	if err := Verify("secret", old, body, 0); err != nil {
+		t.Errorf("expected signature to verify without tolerance, got %s", err)
+	}
+}
+
+func TestVerify_Malformed(t *testing.T) {
+	tests := []string{"", "t=1", "v1=00", "t=x,v1=00", "t=1,v1=zz"}
+	for _, signature := range tests {
+		if err := Verify("secret", signature, nil, 0); err != ErrInvalidSignature {
+			t.Errorf("expected ErrInvalidSignature for %q, got %v", signature, err)
+		}
+	}
+}
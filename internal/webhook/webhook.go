@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"time"
+
+	admin "github.com/welthee/dinonce/v2/internal/admin/generated"
+)
+
+var ErrNoSuchWebhook = errors.New("no such webhook")
+
+// Delivery is a lineage event claimed for delivery to a webhook.
+type Delivery struct {
+	Id        int64
+	WebhookId string
+	Url       string
+	Secret    string
+	Attempts  int
+	Event     admin.LineageEvent
+}
+
+// Store manages webhooks and the deliveries of lineage events to them. The webhook calls are scoped to the namespace
+// carried by their context, see ticket.WithNamespace, the delivery calls span every namespace.
+type Store interface {
+	CreateWebhook(ctx context.Context, request *admin.WebhookCreationRequest) (*admin.Webhook, error)
+	GetWebhook(ctx context.Context, webhookId string) (*admin.Webhook, error)
+	ListWebhooks(ctx context.Context) (*admin.WebhookListResponse, error)
+	DeleteWebhook(ctx context.Context, webhookId string) error
+	ListDeadLetters(ctx context.Context, webhookId string) (*admin.WebhookDeliveryListResponse, error)
+	ReplayDeadLetters(ctx context.Context, webhookId string, request *admin.WebhookReplayRequest) (*admin.WebhookReplayResponse, error)
+
+	// ClaimDeliveries returns up to limit deliveries which are due, hiding them from other claims for the lease.
+	// Every claimed delivery is either completed or failed, failing it without a next attempt makes it a dead letter.
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]Delivery, error)
+	CompleteDelivery(ctx context.Context, deliveryId int64, statusCode int) error
+	FailDelivery(ctx context.Context, deliveryId int64, statusCode int, lastError string, nextAttemptAt *time.Time) error
+	PurgeDeliveries(ctx context.Context, deliveredBefore time.Time) error
+}
+
+// NewSecret returns a random key to sign the deliveries of a webhook with.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// ValidateURL checks that events can be POSTed to the given webhook URL.
+func ValidateURL(rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("webhook URL must be http or https")
+	}
+
+	if u.Host == "" {
+		return errors.New("webhook URL must be absolute")
+	}
+
+	return nil
+}
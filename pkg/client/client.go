@@ -0,0 +1,205 @@
+// Package client is a Go client of the dinonce ticketing API. It wraps the generated client of the OpenAPI contract,
+// see package openapi, turning error responses into typed errors and retrying requests which failed because of
+// concurrent changes of the same lineage.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+// HeaderActor names the caller a ticket change is attributed to in the ticket history.
+const HeaderActor = "X-Actor"
+
+// RetryPolicy configures the retries of requests failing with ErrTooManyConcurrentRequests. The n-th retry waits a
+// random duration between BaseDelay and BaseDelay * 2^n, capped by MaxDelay.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of clients created without WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   10 * time.Millisecond,
+	MaxDelay:    1 * time.Second,
+}
+
+// Client calls the API of a dinonce server within one namespace.
+type Client struct {
+	api   *openapi.Client
+	retry RetryPolicy
+}
+
+type options struct {
+	namespace  string
+	apiKey     string
+	actor      string
+	httpClient openapi.HttpRequestDoer
+	retry      RetryPolicy
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithNamespace scopes the client to a namespace other than the default one.
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithAPIKey sends the API key of the namespace as bearer token.
+func WithAPIKey(key string) Option {
+	return func(o *options) {
+		o.apiKey = key
+	}
+}
+
+// WithActor attributes the ticket changes of the client to actor.
+func WithActor(actor string) Option {
+	return func(o *options) {
+		o.actor = actor
+	}
+}
+
+// WithHTTPClient sends the requests of the client through httpClient instead of http.DefaultClient.
+func WithHTTPClient(httpClient openapi.HttpRequestDoer) Option {
+	return func(o *options) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. A MaxAttempts of 1 disables retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// New creates a client of the server at the given base URL, e.g. http://dinonce:5010.
+func New(server string, opts ...Option) (*Client, error) {
+	o := &options{retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.retry.MaxAttempts < 1 {
+		return nil, fmt.Errorf("retry policy must allow at least one attempt")
+	}
+
+	// the /v2 routes send problems, which carry the details of errors
+	base := strings.TrimSuffix(server, "/") + "/v2"
+	if o.namespace != "" {
+		base += "/namespaces/" + url.PathEscape(o.namespace)
+	}
+
+	clientOpts := []openapi.ClientOption{
+		openapi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if o.apiKey != "" {
+				req.Header.Set("Authorization", "Bearer "+o.apiKey)
+			}
+			if o.actor != "" {
+				req.Header.Set(HeaderActor, o.actor)
+			}
+
+			return nil
+		}),
+	}
+	if o.httpClient != nil {
+		clientOpts = append(clientOpts, openapi.WithHTTPClient(o.httpClient))
+	}
+
+	api, err := openapi.NewClient(base, clientOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		api:   api,
+		retry: o.retry,
+	}, nil
+}
+
+// API returns the generated client the client wraps, for the operations it does not cover such as event streams.
+func (c *Client) API() *openapi.Client {
+	return c.api
+}
+
+// do sends the request of call, retrying it on ErrTooManyConcurrentRequests, and decodes a successful response into
+// a T. Responses without content are returned as nil.
+func do[T any](ctx context.Context, c *Client, call func() (*http.Response, error)) (*T, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := call()
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := decode[T](resp)
+		if err == nil || attempt >= c.retry.MaxAttempts || !isRetryable(err) {
+			return result, err
+		}
+
+		if err := sleep(ctx, c.retry.delay(attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func decode[T any](resp *http.Response) (*T, error) {
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, decodeError(resp)
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+
+	result := new(T)
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("can not decode response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	max := p.BaseDelay
+	for i := 0; i < attempt && max < p.MaxDelay; i++ {
+		max *= 2
+	}
+	if max > p.MaxDelay {
+		max = p.MaxDelay
+	}
+
+	if max <= p.BaseDelay {
+		return p.BaseDelay
+	}
+
+	return p.BaseDelay + time.Duration(rand.Int63n(int64(max-p.BaseDelay)))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,233 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/welthee/dinonce/v2/pkg/client"
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+var fastRetries = client.RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond,
+	MaxDelay:    5 * time.Millisecond,
+}
+
+func writeProblem(w http.ResponseWriter, status int, problem map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+func writeLease(w http.ResponseWriter, extId string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(openapi.TicketLeaseResponse{
+		Leases: &[]openapi.TicketLease{{LineageId: "l1", ExtId: extId, Nonce: 7, State: openapi.TicketLeaseStateLeased}},
+	})
+}
+
+func TestClient_LeaseTickets_RetriesConflicts(t *testing.T) {
+	var mu sync.Mutex
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path != "/v2/namespaces/ns/lineages/l1/tickets" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer key" || r.Header.Get(client.HeaderActor) != "tester" {
+			t.Errorf("missing credentials or actor")
+		}
+
+		attempts++
+		if attempts < 3 {
+			writeProblem(w, http.StatusConflict, map[string]interface{}{
+				"type":   "urn:dinonce:problem:too_many_concurrent_requests",
+				"title":  "Too many concurrent requests",
+				"status": http.StatusConflict,
+			})
+			return
+		}
+
+		writeLease(w, "tx1")
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, client.WithNamespace("ns"), client.WithAPIKey("key"),
+		client.WithActor("tester"), client.WithRetryPolicy(fastRetries))
+	if err != nil {
+		t.Fatalf("can not create client %s", err)
+	}
+
+	resp, err := c.LeaseTickets(context.Background(), "l1", nil, openapi.TicketLeaseRequest{ExtIds: []string{"tx1"}})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+	if len(*resp.Leases) != 1 || (*resp.Leases)[0].Nonce != 7 {
+		t.Errorf("unexpected leases %v", *resp.Leases)
+	}
+}
+
+func TestClient_LeaseTickets_TypedError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeProblem(w, http.StatusTooManyRequests, map[string]interface{}{
+			"type":                "urn:dinonce:problem:too_many_leased_tickets",
+			"title":               "Too many leased tickets",
+			"status":              http.StatusTooManyRequests,
+			"detail":              "too many leased tickets",
+			"lineageId":           "l1",
+			"leasedNonceCount":    5,
+			"releasedNonceCount":  1,
+			"maxLeasedNonceCount": 4,
+		})
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, client.WithRetryPolicy(fastRetries))
+	if err != nil {
+		t.Fatalf("can not create client %s", err)
+	}
+
+	_, err = c.LeaseTickets(context.Background(), "l1", nil, openapi.TicketLeaseRequest{ExtIds: []string{"tx1"}})
+	if !errors.Is(err, client.ErrTooManyLeasedTickets) {
+		t.Fatalf("expected %s, got %v", client.ErrTooManyLeasedTickets, err)
+	}
+	if errors.Is(err, client.ErrTooManyConcurrentRequests) {
+		t.Errorf("expected error not to match %s", client.ErrTooManyConcurrentRequests)
+	}
+
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected a client.Error, got %T", err)
+	}
+	if apiErr.LineageId != "l1" || apiErr.MaxLeasedNonceCount == nil || *apiErr.MaxLeasedNonceCount != 4 {
+		t.Errorf("unexpected error details %+v", apiErr)
+	}
+}
+
+func TestClient_GetTicket_NoSuchTicket(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeProblem(w, http.StatusNotFound, map[string]interface{}{
+			"type":   "urn:dinonce:problem:not_found",
+			"title":  "Not found",
+			"status": http.StatusNotFound,
+			"detail": "no such ticket",
+		})
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL)
+	if err != nil {
+		t.Fatalf("can not create client %s", err)
+	}
+
+	_, err = c.GetTicket(context.Background(), "l1", "tx1")
+	if !errors.Is(err, client.ErrNoSuchTicket) || !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("expected %s, got %v", client.ErrNoSuchTicket, err)
+	}
+	if errors.Is(err, client.ErrNoSuchLineage) {
+		t.Errorf("expected error not to match %s", client.ErrNoSuchLineage)
+	}
+}
+
+func TestClient_WithLease(t *testing.T) {
+	var mu sync.Mutex
+	var updates []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPost:
+			writeLease(w, "tx1")
+		case http.MethodPatch:
+			var req openapi.TicketUpdateRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			updates = append(updates, string(req.State))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL)
+	if err != nil {
+		t.Fatalf("can not create client %s", err)
+	}
+
+	err = c.WithLease(context.Background(), "l1", "tx1", nil, func(ctx context.Context, lease openapi.TicketLease) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("can not lease ticket %s", err)
+	}
+	if len(updates) != 0 {
+		t.Errorf("expected the ticket to stay leased, got updates %v", updates)
+	}
+
+	failure := errors.New("can not send transaction")
+	err = c.WithLease(context.Background(), "l1", "tx1", nil, func(ctx context.Context, lease openapi.TicketLease) error {
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Errorf("expected %s, got %v", failure, err)
+	}
+	if len(updates) != 1 || updates[0] != "released" {
+		t.Errorf("expected the ticket to be released, got updates %v", updates)
+	}
+}
+
+func TestClient_StreamLineageEvents_ResumesAfterLastEvent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Last-Event-ID") != "l1:1" {
+			t.Errorf("expected stream to resume after l1:1, got %q", r.Header.Get("Last-Event-ID"))
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(": keep-alive\n\n"))
+		_, _ = w.Write([]byte("id: l1:2\nevent: ticket_leased\n" +
+			`data: {"lineageId":"l1","seq":2,"type":"ticket_leased","extId":"tx2","createdAt":"2026-01-01T00:00:00Z"}` +
+			"\n\n"))
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL)
+	if err != nil {
+		t.Fatalf("can not create client %s", err)
+	}
+
+	stream, err := c.StreamLineageEvents(context.Background(), "l1", "l1:1")
+	if err != nil {
+		t.Fatalf("can not open stream %s", err)
+	}
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	e, err := stream.Next()
+	if err != nil {
+		t.Fatalf("can not read event %s", err)
+	}
+	if e.Seq != 2 || e.ExtId == nil || *e.ExtId != "tx2" {
+		t.Errorf("unexpected event %v", e)
+	}
+	if stream.LastEventId() != "l1:2" {
+		t.Errorf("expected last event id l1:2, got %s", stream.LastEventId())
+	}
+
+	if _, err := stream.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("expected end of stream, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+const problemTypePrefix = "urn:dinonce:problem:"
+
+// Error is an error response of the server. Match it against the sentinel errors of the package with errors.Is, and
+// use errors.As for its details.
+type Error struct {
+	StatusCode int
+	// Code is the machine-readable error code, e.g. too_many_leased_tickets.
+	Code    string
+	Message string
+
+	// LineageId is the lineage the request addressed, if any.
+	LineageId string
+	// The counters of the lineage, sent with ErrTooManyLeasedTickets.
+	LeasedNonceCount    *int
+	ReleasedNonceCount  *int
+	MaxLeasedNonceCount *int
+}
+
+// The errors of the server. ErrNoSuchLineage and ErrNoSuchTicket are the two kinds of ErrNotFound.
+var (
+	ErrBadRequest                = &Error{Code: "bad_request"}
+	ErrUnauthorized              = &Error{Code: "unauthorized"}
+	ErrNotFound                  = &Error{Code: "not_found"}
+	ErrNoSuchLineage             = &Error{Code: "not_found", Message: "no such lineage"}
+	ErrNoSuchTicket              = &Error{Code: "not_found", Message: "no such ticket"}
+	ErrNoSuchNamespace           = &Error{Code: "not_found", Message: "no such namespace"}
+	ErrTooManyLeasedTickets      = &Error{Code: "too_many_leased_tickets"}
+	ErrTooManyConcurrentRequests = &Error{Code: "too_many_concurrent_requests"}
+	ErrNamespaceLimitExceeded    = &Error{Code: "namespace_limit_exceeded"}
+	ErrLineageConflict           = &Error{Code: "lineage_conflict"}
+	ErrNoContiguousRange         = &Error{Code: "no_contiguous_range"}
+	ErrLineagePaused             = &Error{Code: "lineage_paused"}
+	ErrLineageVersionMismatch    = &Error{Code: "precondition_failed"}
+	ErrIdempotencyKeyInProgress  = &Error{Code: "idempotency_key_in_progress"}
+	ErrIdempotencyKeyReused      = &Error{Code: "idempotency_key_reused"}
+)
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return e.Code
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Is reports whether target is a sentinel error of the same code, and of the same message if the sentinel has one.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	return t.Code == e.Code && (t.Message == "" || t.Message == e.Message)
+}
+
+func isRetryable(err error) bool {
+	e, ok := err.(*Error)
+
+	return ok && e.Is(ErrTooManyConcurrentRequests)
+}
+
+// decodeError reads the problem or error of an error response.
+func decodeError(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	e := &Error{StatusCode: resp.StatusCode}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/problem+json":
+		var problem openapi.Problem
+		if err := json.Unmarshal(body, &problem); err != nil {
+			return fmt.Errorf("can not decode problem of status %d: %w", resp.StatusCode, err)
+		}
+
+		e.Code = strings.TrimPrefix(problem.Type, problemTypePrefix)
+		e.Message = valueOrZero(problem.Detail)
+		e.LineageId = valueOrZero(problem.LineageId)
+		e.LeasedNonceCount = problem.LeasedNonceCount
+		e.ReleasedNonceCount = problem.ReleasedNonceCount
+		e.MaxLeasedNonceCount = problem.MaxLeasedNonceCount
+	case "application/json":
+		var apiErr openapi.Error
+		if err := json.Unmarshal(body, &apiErr); err != nil {
+			return fmt.Errorf("can not decode error of status %d: %w", resp.StatusCode, err)
+		}
+
+		e.Code = apiErr.Code
+		e.Message = apiErr.Message
+	default:
+		e.Code = strings.ToLower(strings.ReplaceAll(http.StatusText(resp.StatusCode), " ", "_"))
+		e.Message = strings.TrimSpace(string(body))
+	}
+
+	return e
+}
+
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+// EventStream reads the server-sent events of a lineage event stream.
+type EventStream struct {
+	body        io.ReadCloser
+	reader      *bufio.Reader
+	lastEventId string
+}
+
+// StreamLineageEvents opens the event stream of a lineage. Without lastEventId the stream starts with the events
+// recorded after it was opened, otherwise it resumes after that event.
+func (c *Client) StreamLineageEvents(ctx context.Context, lineageId string, lastEventId string) (*EventStream, error) {
+	params := &openapi.StreamLineageEventsParams{}
+	if lastEventId != "" {
+		params.LastEventID = &lastEventId
+	}
+
+	return openStream(c.api.StreamLineageEvents(ctx, lineageId, params))
+}
+
+// StreamLineagesEvents opens the event stream of the lineages matching the label selector and extId prefix of
+// params, which may be nil.
+func (c *Client) StreamLineagesEvents(ctx context.Context, params *openapi.StreamLineagesEventsParams) (
+	*EventStream, error) {
+
+	if params == nil {
+		params = &openapi.StreamLineagesEventsParams{}
+	}
+
+	return openStream(c.api.StreamLineagesEvents(ctx, params))
+}
+
+func openStream(resp *http.Response, err error) (*EventStream, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		return nil, decodeError(resp)
+	}
+
+	return &EventStream{
+		body:   resp.Body,
+		reader: bufio.NewReader(resp.Body),
+	}, nil
+}
+
+// Next blocks until the next event arrives. It returns io.EOF once the server ended the stream, after which the
+// stream can be reopened with LastEventId.
+func (s *EventStream) Next() (*openapi.LineageEvent, error) {
+	var data strings.Builder
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && line == "" {
+				return nil, io.EOF
+			}
+			if err != io.EOF {
+				return nil, err
+			}
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if line == "" {
+			if data.Len() == 0 {
+				continue
+			}
+
+			event := &openapi.LineageEvent{}
+			if err := json.Unmarshal([]byte(data.String()), event); err != nil {
+				return nil, fmt.Errorf("can not decode event: %w", err)
+			}
+
+			return event, nil
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			s.lastEventId = value
+		case "data":
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(value)
+		}
+	}
+}
+
+// LastEventId returns the id of the last event received, to resume the stream after it.
+func (s *EventStream) LastEventId() string {
+	return s.lastEventId
+}
+
+// Close closes the stream.
+func (s *EventStream) Close() error {
+	return s.body.Close()
+}
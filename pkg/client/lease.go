@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+// releaseTimeout bounds the release of a ticket whose lease failed, which can not use the context of the lease as
+// it may be the reason of the failure.
+const releaseTimeout = 10 * time.Second
+
+// WithLease leases the ticket extId of a lineage and calls fn with its lease. If fn fails or panics the ticket is
+// released, so that its nonce is leased again instead of leaving a gap. On success the ticket stays leased, to be
+// closed once the transaction using the nonce is final. params may be nil.
+func (c *Client) WithLease(ctx context.Context, lineageId string, extId string, params *openapi.LeaseTicketParams,
+	fn func(ctx context.Context, lease openapi.TicketLease) error) (err error) {
+
+	resp, err := c.LeaseTickets(ctx, lineageId, params, openapi.TicketLeaseRequest{ExtIds: []string{extId}})
+	if err != nil {
+		return err
+	}
+	if resp.Leases == nil || len(*resp.Leases) != 1 {
+		return fmt.Errorf("expected a single lease of ticket %s", extId)
+	}
+
+	succeeded := false
+	defer func() {
+		if succeeded {
+			return
+		}
+
+		releaseCtx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+
+		if releaseErr := c.ReleaseTicket(releaseCtx, lineageId, extId, nil); releaseErr != nil && err != nil {
+			err = fmt.Errorf("%w, and can not release ticket %s: %v", err, extId, releaseErr)
+		}
+	}()
+
+	if err := fn(ctx, (*resp.Leases)[0]); err != nil {
+		return err
+	}
+	succeeded = true
+
+	return nil
+}
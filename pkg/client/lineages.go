@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+// CreateLineage creates a lineage, or returns the existing lineage with the same extId if its configuration matches.
+func (c *Client) CreateLineage(ctx context.Context, request openapi.LineageCreationRequest) (
+	*openapi.LineageCreationResponse, error) {
+
+	return do[openapi.LineageCreationResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.CreateLineage(ctx, request)
+	})
+}
+
+// GetLineage returns the lineage with the given id.
+func (c *Client) GetLineage(ctx context.Context, lineageId string) (*openapi.LineageGetResponse, error) {
+	return do[openapi.LineageGetResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetLineage(ctx, lineageId)
+	})
+}
+
+// GetLineageByExtId returns the lineage with the given extId.
+func (c *Client) GetLineageByExtId(ctx context.Context, extId string) (*openapi.LineageGetResponse, error) {
+	return do[openapi.LineageGetResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetLineageByExtId(ctx, &openapi.GetLineageByExtIdParams{ExtId: extId})
+	})
+}
+
+// GetLineageByAddress returns the lineage registered for the given chain and address.
+func (c *Client) GetLineageByAddress(ctx context.Context, chainId int64, address string) (
+	*openapi.LineageGetResponse, error) {
+
+	return do[openapi.LineageGetResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetLineageByAddress(ctx, &openapi.GetLineageByAddressParams{ChainId: chainId, Address: address})
+	})
+}
+
+// UpdateLineage replaces the labels of a lineage.
+func (c *Client) UpdateLineage(ctx context.Context, lineageId string, request openapi.LineageUpdateRequest) (
+	*openapi.LineageGetResponse, error) {
+
+	return do[openapi.LineageGetResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.UpdateLineage(ctx, lineageId, request)
+	})
+}
+
+// ListLineages returns a page of the lineages of the namespace.
+func (c *Client) ListLineages(ctx context.Context, params *openapi.ListLineagesParams) (
+	*openapi.LineageListResponse, error) {
+
+	return do[openapi.LineageListResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.ListLineages(ctx, params)
+	})
+}
+
+// GetLineageStats returns the aggregated counters of the lineages of the namespace.
+func (c *Client) GetLineageStats(ctx context.Context, params *openapi.GetLineageStatsParams) (
+	*openapi.LineageStatsResponse, error) {
+
+	return do[openapi.LineageStatsResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetLineageStats(ctx, params)
+	})
+}
+
+// CloneLineage copies the counters, the released nonces and optionally the tickets of a lineage into a new lineage.
+func (c *Client) CloneLineage(ctx context.Context, lineageId string, request openapi.LineageCloneRequest) (
+	*openapi.LineageGetResponse, error) {
+
+	return do[openapi.LineageGetResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.CloneLineage(ctx, lineageId, request)
+	})
+}
+
+// ListLineageEvents returns a page of the events of a lineage.
+func (c *Client) ListLineageEvents(ctx context.Context, lineageId string, params *openapi.ListLineageEventsParams) (
+	*openapi.LineageEventListResponse, error) {
+
+	return do[openapi.LineageEventListResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.ListLineageEvents(ctx, lineageId, params)
+	})
+}
+
+// GetNonce returns the status of a nonce of a lineage, with the ticket holding it if any.
+func (c *Client) GetNonce(ctx context.Context, lineageId string, nonce int64) (*openapi.NonceGetResponse, error) {
+	return do[openapi.NonceGetResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetNonce(ctx, lineageId, nonce)
+	})
+}
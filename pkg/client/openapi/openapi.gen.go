@@ -0,0 +1,3816 @@
+// Package openapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.13.2 DO NOT EDIT.
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+const (
+	NamespaceApiKeyScopes = "namespaceApiKey.Scopes"
+)
+
+// Defines values for LineageEventType.
+const (
+	LineageEventTypeLineageCreated          LineageEventType = "lineage_created"
+	LineageEventTypeLineageLimitApproaching LineageEventType = "lineage_limit_approaching"
+	LineageEventTypeLineageUpdated          LineageEventType = "lineage_updated"
+	LineageEventTypeTicketClosed            LineageEventType = "ticket_closed"
+	LineageEventTypeTicketLeased            LineageEventType = "ticket_leased"
+	LineageEventTypeTicketReleased          LineageEventType = "ticket_released"
+	LineageEventTypeTicketReplaced          LineageEventType = "ticket_replaced"
+	LineageEventTypeTicketTransferred       LineageEventType = "ticket_transferred"
+)
+
+// Defines values for NonceGetResponseStatus.
+const (
+	NonceGetResponseStatusClosed      NonceGetResponseStatus = "closed"
+	NonceGetResponseStatusLeased      NonceGetResponseStatus = "leased"
+	NonceGetResponseStatusNeverIssued NonceGetResponseStatus = "never_issued"
+	NonceGetResponseStatusReleased    NonceGetResponseStatus = "released"
+	NonceGetResponseStatusUnused      NonceGetResponseStatus = "unused"
+)
+
+// Defines values for TicketBulkUpdateItemState.
+const (
+	TicketBulkUpdateItemStateClosed   TicketBulkUpdateItemState = "closed"
+	TicketBulkUpdateItemStateReleased TicketBulkUpdateItemState = "released"
+)
+
+// Defines values for TicketBulkUpdateResultOutcome.
+const (
+	TicketBulkUpdateResultOutcomeAlreadyClosed TicketBulkUpdateResultOutcome = "already_closed"
+	TicketBulkUpdateResultOutcomeClosed        TicketBulkUpdateResultOutcome = "closed"
+	TicketBulkUpdateResultOutcomeNoSuchTicket  TicketBulkUpdateResultOutcome = "no_such_ticket"
+	TicketBulkUpdateResultOutcomeReleased      TicketBulkUpdateResultOutcome = "released"
+)
+
+// Defines values for TicketHistoryEntryAction.
+const (
+	TicketHistoryEntryActionClosed        TicketHistoryEntryAction = "closed"
+	TicketHistoryEntryActionForceClosed   TicketHistoryEntryAction = "force_closed"
+	TicketHistoryEntryActionForceReleased TicketHistoryEntryAction = "force_released"
+	TicketHistoryEntryActionLeased        TicketHistoryEntryAction = "leased"
+	TicketHistoryEntryActionReLeased      TicketHistoryEntryAction = "re_leased"
+	TicketHistoryEntryActionReleased      TicketHistoryEntryAction = "released"
+	TicketHistoryEntryActionReplaced      TicketHistoryEntryAction = "replaced"
+	TicketHistoryEntryActionTransferred   TicketHistoryEntryAction = "transferred"
+)
+
+// Defines values for TicketLeaseState.
+const (
+	TicketLeaseStateClosed   TicketLeaseState = "closed"
+	TicketLeaseStateLeased   TicketLeaseState = "leased"
+	TicketLeaseStateReplaced TicketLeaseState = "replaced"
+)
+
+// Defines values for TicketLeaseRejectionCode.
+const (
+	TicketLeaseRejectionCodeNotLeasable          TicketLeaseRejectionCode = "not_leasable"
+	TicketLeaseRejectionCodeTooManyLeasedTickets TicketLeaseRejectionCode = "too_many_leased_tickets"
+)
+
+// Defines values for TicketUpdateRequestState.
+const (
+	TicketUpdateRequestStateClosed   TicketUpdateRequestState = "closed"
+	TicketUpdateRequestStateReleased TicketUpdateRequestState = "released"
+)
+
+// Defines values for GetTicketsParamsState.
+const (
+	GetTicketsParamsStateClosed   GetTicketsParamsState = "closed"
+	GetTicketsParamsStateLeased   GetTicketsParamsState = "leased"
+	GetTicketsParamsStateReplaced GetTicketsParamsState = "replaced"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Labels Arbitrary key/value metadata attached to a lineage.
+type Labels map[string]string
+
+// LineageCloneRequest defines model for LineageCloneRequest.
+type LineageCloneRequest struct {
+	ExtId          string `json:"extId"`
+	IncludeTickets *bool  `json:"includeTickets,omitempty"`
+
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels *Labels `json:"labels,omitempty"`
+}
+
+// LineageCreationRequest defines model for LineageCreationRequest.
+type LineageCreationRequest struct {
+	// Address Hex encoded account address, must be set together with chainId. Checksummed and lowercase spellings of an address are equal.
+	Address *string `json:"address,omitempty"`
+
+	// ChainId Chain the lineage's account lives on, must be set together with address.
+	ChainId *int64 `json:"chainId,omitempty"`
+	ExtId   string `json:"extId"`
+
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels              *Labels `json:"labels,omitempty"`
+	MaxLeasedNonceCount int     `json:"maxLeasedNonceCount"`
+	StartLeasingFrom    *int    `json:"startLeasingFrom,omitempty"`
+}
+
+// LineageCreationResponse defines model for LineageCreationResponse.
+type LineageCreationResponse struct {
+	ExtId string `json:"extId"`
+	Id    string `json:"id"`
+}
+
+// LineageEvent defines model for LineageEvent.
+type LineageEvent struct {
+	// Actor The caller named in the X-Actor header of the change, as asserted by the caller.
+	Actor     *string   `json:"actor,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExtId The extId of the ticket, only set for ticket events.
+	ExtId     *string `json:"extId,omitempty"`
+	LineageId string  `json:"lineageId"`
+
+	// Nonce The nonce of the ticket, only set for ticket events.
+	Nonce *int64 `json:"nonce,omitempty"`
+	Seq   int64  `json:"seq"`
+
+	// Type lineage_limit_approaching marks the moment 90% of the maxLeasedNonceCount of the lineage became in use.
+	Type LineageEventType `json:"type"`
+}
+
+// LineageEventListResponse defines model for LineageEventListResponse.
+type LineageEventListResponse struct {
+	Events []LineageEvent `json:"events"`
+}
+
+// LineageEventType lineage_limit_approaching marks the moment 90% of the maxLeasedNonceCount of the lineage became in use.
+type LineageEventType string
+
+// LineageGetResponse defines model for LineageGetResponse.
+type LineageGetResponse struct {
+	// Address Lowercase hex encoded account address.
+	Address          *string    `json:"address,omitempty"`
+	ChainId          *int64     `json:"chainId,omitempty"`
+	ClosedNonceCount int        `json:"closedNonceCount"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	ExtId            string     `json:"extId"`
+	Id               string     `json:"id"`
+
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels              Labels `json:"labels"`
+	LeasedNonceCount    int    `json:"leasedNonceCount"`
+	MaxLeasedNonceCount int    `json:"maxLeasedNonceCount"`
+	MaxNonceValue       int    `json:"maxNonceValue"`
+	Namespace           string `json:"namespace"`
+	NextNonce           int    `json:"nextNonce"`
+	ReleasedNonceCount  int    `json:"releasedNonceCount"`
+	StartLeasingFrom    *int   `json:"startLeasingFrom,omitempty"`
+	Version             int    `json:"version"`
+}
+
+// LineageListResponse defines model for LineageListResponse.
+type LineageListResponse struct {
+	Lineages []LineageGetResponse `json:"lineages"`
+
+	// NextCursor Cursor of the next page, absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// LineageStatsResponse defines model for LineageStatsResponse.
+type LineageStatsResponse struct {
+	ClosedNonceCount    int `json:"closedNonceCount"`
+	LeasedNonceCount    int `json:"leasedNonceCount"`
+	LineageCount        int `json:"lineageCount"`
+	MaxLeasedNonceCount int `json:"maxLeasedNonceCount"`
+	ReleasedNonceCount  int `json:"releasedNonceCount"`
+}
+
+// LineageUpdateRequest defines model for LineageUpdateRequest.
+type LineageUpdateRequest struct {
+	// Labels Arbitrary key/value metadata attached to a lineage.
+	Labels Labels `json:"labels"`
+}
+
+// NonceGetResponse defines model for NonceGetResponse.
+type NonceGetResponse struct {
+	// ExtId The extId of the ticket holding the nonce, only set for leased and closed nonces.
+	ExtId     *string                `json:"extId,omitempty"`
+	LeasedAt  *time.Time             `json:"leasedAt,omitempty"`
+	LineageId string                 `json:"lineageId"`
+	Nonce     int64                  `json:"nonce"`
+	Status    NonceGetResponseStatus `json:"status"`
+}
+
+// NonceGetResponseStatus defines model for NonceGetResponse.Status.
+type NonceGetResponseStatus string
+
+// Problem An RFC 7807 problem, sent by the /v2 routes in place of an Error.
+type Problem struct {
+	// Detail Explanation of this occurrence of the problem.
+	Detail *string `json:"detail,omitempty"`
+
+	// Instance Path of the request.
+	Instance *string `json:"instance,omitempty"`
+
+	// LeasedNonceCount The leasedNonceCount of the lineage, sent with too_many_leased_tickets.
+	LeasedNonceCount *int `json:"leasedNonceCount,omitempty"`
+
+	// LineageId The lineage the request addressed.
+	LineageId *string `json:"lineageId,omitempty"`
+
+	// MaxLeasedNonceCount The maxLeasedNonceCount of the lineage, sent with too_many_leased_tickets.
+	MaxLeasedNonceCount *int `json:"maxLeasedNonceCount,omitempty"`
+
+	// ReleasedNonceCount The releasedNonceCount of the lineage, sent with too_many_leased_tickets.
+	ReleasedNonceCount *int `json:"releasedNonceCount,omitempty"`
+	Status             int  `json:"status"`
+
+	// Title Summary of the kind of problem.
+	Title string `json:"title"`
+
+	// Type Identifies the kind of problem as urn:dinonce:problem:{code}, the code being the one of an Error.
+	Type string `json:"type"`
+}
+
+// TicketBulkUpdateItem defines model for TicketBulkUpdateItem.
+type TicketBulkUpdateItem struct {
+	ExtId string                    `json:"extId"`
+	State TicketBulkUpdateItemState `json:"state"`
+}
+
+// TicketBulkUpdateItemState defines model for TicketBulkUpdateItem.State.
+type TicketBulkUpdateItemState string
+
+// TicketBulkUpdateRequest defines model for TicketBulkUpdateRequest.
+type TicketBulkUpdateRequest struct {
+	Tickets []TicketBulkUpdateItem `json:"tickets"`
+}
+
+// TicketBulkUpdateResponse defines model for TicketBulkUpdateResponse.
+type TicketBulkUpdateResponse struct {
+	Results []TicketBulkUpdateResult `json:"results"`
+}
+
+// TicketBulkUpdateResult defines model for TicketBulkUpdateResult.
+type TicketBulkUpdateResult struct {
+	ExtId   string                        `json:"extId"`
+	Outcome TicketBulkUpdateResultOutcome `json:"outcome"`
+}
+
+// TicketBulkUpdateResultOutcome defines model for TicketBulkUpdateResult.Outcome.
+type TicketBulkUpdateResultOutcome string
+
+// TicketHistoryEntry defines model for TicketHistoryEntry.
+type TicketHistoryEntry struct {
+	// Action re_leased marks a lease of a nonce which was held by another ticket before, transferred marks a ticket which took over the nonce of a replaced ticket, the force_ prefixed actions are changes done by an admin.
+	Action TicketHistoryEntryAction `json:"action"`
+
+	// Actor The caller named in the X-Actor header of the change, as asserted by the caller.
+	Actor *string `json:"actor,omitempty"`
+	Nonce int64   `json:"nonce"`
+
+	// Principal The API key the change was made with, as key: followed by the first 12 hex digits of the SHA-256 digest of the key. Absent for changes made without an API key.
+	Principal *string   `json:"principal,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TicketHistoryEntryAction re_leased marks a lease of a nonce which was held by another ticket before, transferred marks a ticket which took over the nonce of a replaced ticket, the force_ prefixed actions are changes done by an admin.
+type TicketHistoryEntryAction string
+
+// TicketHistoryResponse defines model for TicketHistoryResponse.
+type TicketHistoryResponse struct {
+	Entries   []TicketHistoryEntry `json:"entries"`
+	ExtId     string               `json:"extId"`
+	LineageId string               `json:"lineageId"`
+}
+
+// TicketLease defines model for TicketLease.
+type TicketLease struct {
+	ExtId     string           `json:"extId"`
+	LeasedAt  *time.Time       `json:"leasedAt,omitempty"`
+	LineageId string           `json:"lineageId"`
+	Nonce     int              `json:"nonce"`
+	State     TicketLeaseState `json:"state"`
+}
+
+// TicketLeaseState defines model for TicketLease.State.
+type TicketLeaseState string
+
+// TicketLeaseRejection defines model for TicketLeaseRejection.
+type TicketLeaseRejection struct {
+	Code  TicketLeaseRejectionCode `json:"code"`
+	ExtId string                   `json:"extId"`
+}
+
+// TicketLeaseRejectionCode defines model for TicketLeaseRejection.Code.
+type TicketLeaseRejectionCode string
+
+// TicketLeaseRequest defines model for TicketLeaseRequest.
+type TicketLeaseRequest struct {
+	// Contiguous Lease one contiguous range of fresh nonces in the order of extIds. Fails with 422 if the lineage has released nonces waiting to be leased again, or if only some of the extIds are already leased.
+	Contiguous *bool    `json:"contiguous,omitempty"`
+	ExtIds     []string `json:"extIds"`
+
+	// Partial Lease as many of the tickets as the maxLeasedNonceCount of the lineage allows, in the order of extIds. The extIds which could not be leased are returned as rejected instead of failing the request. Can not be combined with contiguous.
+	Partial *bool `json:"partial,omitempty"`
+}
+
+// TicketLeaseResponse defines model for TicketLeaseResponse.
+type TicketLeaseResponse struct {
+	Leases *[]TicketLease `json:"leases,omitempty"`
+
+	// MissingExtIds The requested ticketExtIds without an active or closed lease.
+	MissingExtIds *[]string `json:"missingExtIds,omitempty"`
+
+	// NextCursor Cursor of the next page when listing tickets, absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Rejected The extIds which were not leased by a partial lease request.
+	Rejected *[]TicketLeaseRejection `json:"rejected,omitempty"`
+}
+
+// TicketTransferRequest defines model for TicketTransferRequest.
+type TicketTransferRequest struct {
+	// ExtId The extId of the ticket taking over the nonce.
+	ExtId string `json:"extId"`
+}
+
+// TicketUpdateRequest defines model for TicketUpdateRequest.
+type TicketUpdateRequest struct {
+	State TicketUpdateRequestState `json:"state"`
+}
+
+// TicketUpdateRequestState defines model for TicketUpdateRequest.State.
+type TicketUpdateRequestState string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// LabelSelector defines model for LabelSelector.
+type LabelSelector = string
+
+// LastEventId defines model for LastEventId.
+type LastEventId = string
+
+// GetLineageByExtIdParams defines parameters for GetLineageByExtId.
+type GetLineageByExtIdParams struct {
+	ExtId string `form:"extId" json:"extId"`
+}
+
+// GetLineageByAddressParams defines parameters for GetLineageByAddress.
+type GetLineageByAddressParams struct {
+	ChainId int64  `form:"chainId" json:"chainId"`
+	Address string `form:"address" json:"address"`
+}
+
+// ListLineagesParams defines parameters for ListLineages.
+type ListLineagesParams struct {
+	// LabelSelector Comma separated list of label requirements, all of which must match. Supported requirements are `key=value`, `key!=value`, `key` (label exists) and `!key` (label does not exist).
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	Limit         *int           `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor        *string        `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetLineageStatsParams defines parameters for GetLineageStats.
+type GetLineageStatsParams struct {
+	// LabelSelector Comma separated list of label requirements, all of which must match. Supported requirements are `key=value`, `key!=value`, `key` (label exists) and `!key` (label does not exist).
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// StreamLineagesEventsParams defines parameters for StreamLineagesEvents.
+type StreamLineagesEventsParams struct {
+	// LabelSelector Comma separated list of label requirements, all of which must match. Supported requirements are `key=value`, `key!=value`, `key` (label exists) and `!key` (label does not exist).
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+	ExtIdPrefix   *string        `form:"extIdPrefix,omitempty" json:"extIdPrefix,omitempty"`
+
+	// LastEventID The id of the last server-sent event the client received, the stream resumes after it.
+	LastEventID *LastEventId `json:"Last-Event-ID,omitempty"`
+}
+
+// ListLineageEventsParams defines parameters for ListLineageEvents.
+type ListLineageEventsParams struct {
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// StreamLineageEventsParams defines parameters for StreamLineageEvents.
+type StreamLineageEventsParams struct {
+	// LastEventID The id of the last server-sent event the client received, the stream resumes after it.
+	LastEventID *LastEventId `json:"Last-Event-ID,omitempty"`
+}
+
+// GetTicketsParams defines parameters for GetTickets.
+type GetTicketsParams struct {
+	TicketExtIds *[]string `form:"ticketExtIds,omitempty" json:"ticketExtIds,omitempty"`
+
+	// AllOrNothing Fail with 404 unless every ticket with the given ticketExtIds is found.
+	AllOrNothing *bool                  `form:"allOrNothing,omitempty" json:"allOrNothing,omitempty"`
+	State        *GetTicketsParamsState `form:"state,omitempty" json:"state,omitempty"`
+
+	// LeasedAfter Only list tickets leased at or after the given time.
+	LeasedAfter *time.Time `form:"leasedAfter,omitempty" json:"leasedAfter,omitempty"`
+
+	// LeasedBefore Only list tickets leased before the given time.
+	LeasedBefore *time.Time `form:"leasedBefore,omitempty" json:"leasedBefore,omitempty"`
+	MinNonce     *int64     `form:"minNonce,omitempty" json:"minNonce,omitempty"`
+	MaxNonce     *int64     `form:"maxNonce,omitempty" json:"maxNonce,omitempty"`
+	Limit        *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Cursor       *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetTicketsParamsState defines parameters for GetTickets.
+type GetTicketsParamsState string
+
+// UpdateTicketsParams defines parameters for UpdateTickets.
+type UpdateTicketsParams struct {
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// LeaseTicketParams defines parameters for LeaseTicket.
+type LeaseTicketParams struct {
+	// Wait Seconds to wait for tickets of the lineage to be released or closed when the lease would exceed its maxLeasedNonceCount, instead of failing immediately with 429. Waiting requests are served in order of arrival.
+	Wait *int `form:"wait,omitempty" json:"wait,omitempty"`
+
+	// IdempotencyKey Client chosen key identifying the request. The first response sent for a key of a lineage is stored for a day and replayed to retries of the request with the same key, marked by an Idempotent-Replayed header. Responses of requests which failed without changing tickets, 400, 409, 412, 423 and 429, are not stored and their retries are handled again. Server errors are stored, since the request may have changed tickets before it failed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateTicketParams defines parameters for UpdateTicket.
+type UpdateTicketParams struct {
+	// IdempotencyKey Client chosen key identifying the request. The first response sent for a key of a lineage is stored for a day and replayed to retries of the request with the same key, marked by an Idempotent-Replayed header. Responses of requests which failed without changing tickets, 400, 409, 412, 423 and 429, are not stored and their retries are handled again. Server errors are stored, since the request may have changed tickets before it failed.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// TransferTicketParams defines parameters for TransferTicket.
+type TransferTicketParams struct {
+	// IfMatch Only apply the request if the version of the lineage still equals the given ETag of an earlier read.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// CreateLineageJSONRequestBody defines body for CreateLineage for application/json ContentType.
+type CreateLineageJSONRequestBody = LineageCreationRequest
+
+// UpdateLineageJSONRequestBody defines body for UpdateLineage for application/json ContentType.
+type UpdateLineageJSONRequestBody = LineageUpdateRequest
+
+// CloneLineageJSONRequestBody defines body for CloneLineage for application/json ContentType.
+type CloneLineageJSONRequestBody = LineageCloneRequest
+
+// UpdateTicketsJSONRequestBody defines body for UpdateTickets for application/json ContentType.
+type UpdateTicketsJSONRequestBody = TicketBulkUpdateRequest
+
+// LeaseTicketJSONRequestBody defines body for LeaseTicket for application/json ContentType.
+type LeaseTicketJSONRequestBody = TicketLeaseRequest
+
+// UpdateTicketJSONRequestBody defines body for UpdateTicket for application/json ContentType.
+type UpdateTicketJSONRequestBody = TicketUpdateRequest
+
+// TransferTicketJSONRequestBody defines body for TransferTicket for application/json ContentType.
+type TransferTicketJSONRequestBody = TicketTransferRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetLineageByExtId request
+	GetLineageByExtId(ctx context.Context, params *GetLineageByExtIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateLineageWithBody request with any body
+	CreateLineageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateLineage(ctx context.Context, body CreateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLineageByAddress request
+	GetLineageByAddress(ctx context.Context, params *GetLineageByAddressParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLineages request
+	ListLineages(ctx context.Context, params *ListLineagesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLineageStats request
+	GetLineageStats(ctx context.Context, params *GetLineageStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamLineagesEvents request
+	StreamLineagesEvents(ctx context.Context, params *StreamLineagesEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLineage request
+	GetLineage(ctx context.Context, lineageId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateLineageWithBody request with any body
+	UpdateLineageWithBody(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateLineage(ctx context.Context, lineageId string, body UpdateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloneLineageWithBody request with any body
+	CloneLineageWithBody(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloneLineage(ctx context.Context, lineageId string, body CloneLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLineageEvents request
+	ListLineageEvents(ctx context.Context, lineageId string, params *ListLineageEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNonce request
+	GetNonce(ctx context.Context, lineageId string, nonce int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamLineageEvents request
+	StreamLineageEvents(ctx context.Context, lineageId string, params *StreamLineageEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTickets request
+	GetTickets(ctx context.Context, lineageId string, params *GetTicketsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTicketsWithBody request with any body
+	UpdateTicketsWithBody(ctx context.Context, lineageId string, params *UpdateTicketsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTickets(ctx context.Context, lineageId string, params *UpdateTicketsParams, body UpdateTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LeaseTicketWithBody request with any body
+	LeaseTicketWithBody(ctx context.Context, lineageId string, params *LeaseTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LeaseTicket(ctx context.Context, lineageId string, params *LeaseTicketParams, body LeaseTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTicket request
+	GetTicket(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTicketWithBody request with any body
+	UpdateTicketWithBody(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTicket(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, body UpdateTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTicketHistory request
+	GetTicketHistory(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferTicketWithBody request with any body
+	TransferTicketWithBody(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferTicket(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, body TransferTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetLineageByExtId(ctx context.Context, params *GetLineageByExtIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLineageByExtIdRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLineageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLineageRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLineage(ctx context.Context, body CreateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLineageRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLineageByAddress(ctx context.Context, params *GetLineageByAddressParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLineageByAddressRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLineages(ctx context.Context, params *ListLineagesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLineagesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLineageStats(ctx context.Context, params *GetLineageStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLineageStatsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamLineagesEvents(ctx context.Context, params *StreamLineagesEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamLineagesEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLineage(ctx context.Context, lineageId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLineageRequest(c.Server, lineageId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLineageWithBody(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLineageRequestWithBody(c.Server, lineageId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLineage(ctx context.Context, lineageId string, body UpdateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLineageRequest(c.Server, lineageId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneLineageWithBody(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneLineageRequestWithBody(c.Server, lineageId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneLineage(ctx context.Context, lineageId string, body CloneLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneLineageRequest(c.Server, lineageId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLineageEvents(ctx context.Context, lineageId string, params *ListLineageEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLineageEventsRequest(c.Server, lineageId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNonce(ctx context.Context, lineageId string, nonce int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNonceRequest(c.Server, lineageId, nonce)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamLineageEvents(ctx context.Context, lineageId string, params *StreamLineageEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamLineageEventsRequest(c.Server, lineageId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTickets(ctx context.Context, lineageId string, params *GetTicketsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTicketsRequest(c.Server, lineageId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTicketsWithBody(ctx context.Context, lineageId string, params *UpdateTicketsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTicketsRequestWithBody(c.Server, lineageId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTickets(ctx context.Context, lineageId string, params *UpdateTicketsParams, body UpdateTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTicketsRequest(c.Server, lineageId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LeaseTicketWithBody(ctx context.Context, lineageId string, params *LeaseTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLeaseTicketRequestWithBody(c.Server, lineageId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LeaseTicket(ctx context.Context, lineageId string, params *LeaseTicketParams, body LeaseTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLeaseTicketRequest(c.Server, lineageId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTicket(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTicketRequest(c.Server, lineageId, ticketExtId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTicketWithBody(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTicketRequestWithBody(c.Server, lineageId, ticketExtId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTicket(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, body UpdateTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTicketRequest(c.Server, lineageId, ticketExtId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTicketHistory(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTicketHistoryRequest(c.Server, lineageId, ticketExtId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferTicketWithBody(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferTicketRequestWithBody(c.Server, lineageId, ticketExtId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferTicket(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, body TransferTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferTicketRequest(c.Server, lineageId, ticketExtId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetLineageByExtIdRequest generates requests for GetLineageByExtId
+func NewGetLineageByExtIdRequest(server string, params *GetLineageByExtIdParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "extId", runtime.ParamLocationQuery, params.ExtId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateLineageRequest calls the generic CreateLineage builder with application/json body
+func NewCreateLineageRequest(server string, body CreateLineageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateLineageRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateLineageRequestWithBody generates requests for CreateLineage with any type of body
+func NewCreateLineageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLineageByAddressRequest generates requests for GetLineageByAddress
+func NewGetLineageByAddressRequest(server string, params *GetLineageByAddressParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/by-address")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "chainId", runtime.ParamLocationQuery, params.ChainId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "address", runtime.ParamLocationQuery, params.Address); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListLineagesRequest generates requests for ListLineages
+func NewListLineagesRequest(server string, params *ListLineagesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLineageStatsRequest generates requests for GetLineageStats
+func NewGetLineageStatsRequest(server string, params *GetLineageStatsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamLineagesEventsRequest generates requests for StreamLineagesEvents
+func NewStreamLineagesEventsRequest(server string, params *StreamLineagesEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LabelSelector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExtIdPrefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "extIdPrefix", runtime.ParamLocationQuery, *params.ExtIdPrefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetLineageRequest generates requests for GetLineage
+func NewGetLineageRequest(server string, lineageId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateLineageRequest calls the generic UpdateLineage builder with application/json body
+func NewUpdateLineageRequest(server string, lineageId string, body UpdateLineageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateLineageRequestWithBody(server, lineageId, "application/json", bodyReader)
+}
+
+// NewUpdateLineageRequestWithBody generates requests for UpdateLineage with any type of body
+func NewUpdateLineageRequestWithBody(server string, lineageId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCloneLineageRequest calls the generic CloneLineage builder with application/json body
+func NewCloneLineageRequest(server string, lineageId string, body CloneLineageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloneLineageRequestWithBody(server, lineageId, "application/json", bodyReader)
+}
+
+// NewCloneLineageRequestWithBody generates requests for CloneLineage with any type of body
+func NewCloneLineageRequestWithBody(server string, lineageId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/clone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListLineageEventsRequest generates requests for ListLineageEvents
+func NewListLineageEventsRequest(server string, lineageId string, params *ListLineageEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNonceRequest generates requests for GetNonce
+func NewGetNonceRequest(server string, lineageId string, nonce int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "nonce", runtime.ParamLocationPath, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/nonces/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamLineageEventsRequest generates requests for StreamLineageEvents
+func NewStreamLineageEventsRequest(server string, lineageId string, params *StreamLineageEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTicketsRequest generates requests for GetTickets
+func NewGetTicketsRequest(server string, lineageId string, params *GetTicketsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/tickets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TicketExtIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ticketExtIds", runtime.ParamLocationQuery, *params.TicketExtIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AllOrNothing != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "allOrNothing", runtime.ParamLocationQuery, *params.AllOrNothing); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LeasedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "leasedAfter", runtime.ParamLocationQuery, *params.LeasedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LeasedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "leasedBefore", runtime.ParamLocationQuery, *params.LeasedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinNonce != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minNonce", runtime.ParamLocationQuery, *params.MinNonce); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxNonce != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxNonce", runtime.ParamLocationQuery, *params.MaxNonce); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTicketsRequest calls the generic UpdateTickets builder with application/json body
+func NewUpdateTicketsRequest(server string, lineageId string, params *UpdateTicketsParams, body UpdateTicketsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTicketsRequestWithBody(server, lineageId, params, "application/json", bodyReader)
+}
+
+// NewUpdateTicketsRequestWithBody generates requests for UpdateTickets with any type of body
+func NewUpdateTicketsRequestWithBody(server string, lineageId string, params *UpdateTicketsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/tickets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLeaseTicketRequest calls the generic LeaseTicket builder with application/json body
+func NewLeaseTicketRequest(server string, lineageId string, params *LeaseTicketParams, body LeaseTicketJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLeaseTicketRequestWithBody(server, lineageId, params, "application/json", bodyReader)
+}
+
+// NewLeaseTicketRequestWithBody generates requests for LeaseTicket with any type of body
+func NewLeaseTicketRequestWithBody(server string, lineageId string, params *LeaseTicketParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/tickets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTicketRequest generates requests for GetTicket
+func NewGetTicketRequest(server string, lineageId string, ticketExtId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ticketExtId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/tickets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTicketRequest calls the generic UpdateTicket builder with application/json body
+func NewUpdateTicketRequest(server string, lineageId string, ticketExtId string, params *UpdateTicketParams, body UpdateTicketJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTicketRequestWithBody(server, lineageId, ticketExtId, params, "application/json", bodyReader)
+}
+
+// NewUpdateTicketRequestWithBody generates requests for UpdateTicket with any type of body
+func NewUpdateTicketRequestWithBody(server string, lineageId string, ticketExtId string, params *UpdateTicketParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ticketExtId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/tickets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+		if params.IfMatch != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTicketHistoryRequest generates requests for GetTicketHistory
+func NewGetTicketHistoryRequest(server string, lineageId string, ticketExtId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ticketExtId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/tickets/%s/history", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTransferTicketRequest calls the generic TransferTicket builder with application/json body
+func NewTransferTicketRequest(server string, lineageId string, ticketExtId string, params *TransferTicketParams, body TransferTicketJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTransferTicketRequestWithBody(server, lineageId, ticketExtId, params, "application/json", bodyReader)
+}
+
+// NewTransferTicketRequestWithBody generates requests for TransferTicket with any type of body
+func NewTransferTicketRequestWithBody(server string, lineageId string, ticketExtId string, params *TransferTicketParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "lineageId", runtime.ParamLocationPath, lineageId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ticketExtId", runtime.ParamLocationPath, ticketExtId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/lineages/%s/tickets/%s/transfer", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetLineageByExtIdWithResponse request
+	GetLineageByExtIdWithResponse(ctx context.Context, params *GetLineageByExtIdParams, reqEditors ...RequestEditorFn) (*GetLineageByExtIdResponse, error)
+
+	// CreateLineageWithBodyWithResponse request with any body
+	CreateLineageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLineageResponse, error)
+
+	CreateLineageWithResponse(ctx context.Context, body CreateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLineageResponse, error)
+
+	// GetLineageByAddressWithResponse request
+	GetLineageByAddressWithResponse(ctx context.Context, params *GetLineageByAddressParams, reqEditors ...RequestEditorFn) (*GetLineageByAddressResponse, error)
+
+	// ListLineagesWithResponse request
+	ListLineagesWithResponse(ctx context.Context, params *ListLineagesParams, reqEditors ...RequestEditorFn) (*ListLineagesResponse, error)
+
+	// GetLineageStatsWithResponse request
+	GetLineageStatsWithResponse(ctx context.Context, params *GetLineageStatsParams, reqEditors ...RequestEditorFn) (*GetLineageStatsResponse, error)
+
+	// StreamLineagesEventsWithResponse request
+	StreamLineagesEventsWithResponse(ctx context.Context, params *StreamLineagesEventsParams, reqEditors ...RequestEditorFn) (*StreamLineagesEventsResponse, error)
+
+	// GetLineageWithResponse request
+	GetLineageWithResponse(ctx context.Context, lineageId string, reqEditors ...RequestEditorFn) (*GetLineageResponse, error)
+
+	// UpdateLineageWithBodyWithResponse request with any body
+	UpdateLineageWithBodyWithResponse(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLineageResponse, error)
+
+	UpdateLineageWithResponse(ctx context.Context, lineageId string, body UpdateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLineageResponse, error)
+
+	// CloneLineageWithBodyWithResponse request with any body
+	CloneLineageWithBodyWithResponse(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneLineageResponse, error)
+
+	CloneLineageWithResponse(ctx context.Context, lineageId string, body CloneLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneLineageResponse, error)
+
+	// ListLineageEventsWithResponse request
+	ListLineageEventsWithResponse(ctx context.Context, lineageId string, params *ListLineageEventsParams, reqEditors ...RequestEditorFn) (*ListLineageEventsResponse, error)
+
+	// GetNonceWithResponse request
+	GetNonceWithResponse(ctx context.Context, lineageId string, nonce int64, reqEditors ...RequestEditorFn) (*GetNonceResponse, error)
+
+	// StreamLineageEventsWithResponse request
+	StreamLineageEventsWithResponse(ctx context.Context, lineageId string, params *StreamLineageEventsParams, reqEditors ...RequestEditorFn) (*StreamLineageEventsResponse, error)
+
+	// GetTicketsWithResponse request
+	GetTicketsWithResponse(ctx context.Context, lineageId string, params *GetTicketsParams, reqEditors ...RequestEditorFn) (*GetTicketsResponse, error)
+
+	// UpdateTicketsWithBodyWithResponse request with any body
+	UpdateTicketsWithBodyWithResponse(ctx context.Context, lineageId string, params *UpdateTicketsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTicketsResponse, error)
+
+	UpdateTicketsWithResponse(ctx context.Context, lineageId string, params *UpdateTicketsParams, body UpdateTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTicketsResponse, error)
+
+	// LeaseTicketWithBodyWithResponse request with any body
+	LeaseTicketWithBodyWithResponse(ctx context.Context, lineageId string, params *LeaseTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LeaseTicketResponse, error)
+
+	LeaseTicketWithResponse(ctx context.Context, lineageId string, params *LeaseTicketParams, body LeaseTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*LeaseTicketResponse, error)
+
+	// GetTicketWithResponse request
+	GetTicketWithResponse(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*GetTicketResponse, error)
+
+	// UpdateTicketWithBodyWithResponse request with any body
+	UpdateTicketWithBodyWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTicketResponse, error)
+
+	UpdateTicketWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, body UpdateTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTicketResponse, error)
+
+	// GetTicketHistoryWithResponse request
+	GetTicketHistoryWithResponse(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*GetTicketHistoryResponse, error)
+
+	// TransferTicketWithBodyWithResponse request with any body
+	TransferTicketWithBodyWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferTicketResponse, error)
+
+	TransferTicketWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, body TransferTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferTicketResponse, error)
+}
+
+type GetLineageByExtIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LineageGetResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLineageByExtIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLineageByExtIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLineageResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageCreationResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLineageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLineageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLineageByAddressResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageGetResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLineageByAddressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLineageByAddressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLineagesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageListResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLineagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLineagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLineageStatsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageStatsResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLineageStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLineageStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamLineagesEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamLineagesEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamLineagesEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLineageResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageGetResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLineageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLineageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLineageResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageGetResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLineageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLineageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneLineageResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageGetResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON403                   *Error
+	ApplicationproblemJSON403 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneLineageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneLineageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLineageEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *LineageEventListResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLineageEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLineageEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNonceResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *NonceGetResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNonceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNonceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamLineageEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamLineageEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamLineageEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTicketsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TicketLeaseResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTicketsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTicketsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTicketsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TicketBulkUpdateResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTicketsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTicketsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LeaseTicketResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TicketLeaseResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+	JSON422                   *Error
+	ApplicationproblemJSON422 *Problem
+	JSON423                   *Error
+	ApplicationproblemJSON423 *Problem
+	JSON429                   *Error
+	ApplicationproblemJSON429 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LeaseTicketResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LeaseTicketResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTicketResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TicketLeaseResponse
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTicketResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTicketResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTicketResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+	JSON422                   *Error
+	ApplicationproblemJSON422 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTicketResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTicketResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTicketHistoryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TicketHistoryResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTicketHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTicketHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferTicketResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TicketLeaseResponse
+	JSON400                   *Error
+	ApplicationproblemJSON400 *Problem
+	JSON404                   *Error
+	ApplicationproblemJSON404 *Problem
+	JSON409                   *Error
+	ApplicationproblemJSON409 *Problem
+	JSON412                   *Error
+	ApplicationproblemJSON412 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferTicketResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferTicketResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetLineageByExtIdWithResponse request returning *GetLineageByExtIdResponse
+func (c *ClientWithResponses) GetLineageByExtIdWithResponse(ctx context.Context, params *GetLineageByExtIdParams, reqEditors ...RequestEditorFn) (*GetLineageByExtIdResponse, error) {
+	rsp, err := c.GetLineageByExtId(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLineageByExtIdResponse(rsp)
+}
+
+// CreateLineageWithBodyWithResponse request with arbitrary body returning *CreateLineageResponse
+func (c *ClientWithResponses) CreateLineageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLineageResponse, error) {
+	rsp, err := c.CreateLineageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLineageResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateLineageWithResponse(ctx context.Context, body CreateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLineageResponse, error) {
+	rsp, err := c.CreateLineage(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLineageResponse(rsp)
+}
+
+// GetLineageByAddressWithResponse request returning *GetLineageByAddressResponse
+func (c *ClientWithResponses) GetLineageByAddressWithResponse(ctx context.Context, params *GetLineageByAddressParams, reqEditors ...RequestEditorFn) (*GetLineageByAddressResponse, error) {
+	rsp, err := c.GetLineageByAddress(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLineageByAddressResponse(rsp)
+}
+
+// ListLineagesWithResponse request returning *ListLineagesResponse
+func (c *ClientWithResponses) ListLineagesWithResponse(ctx context.Context, params *ListLineagesParams, reqEditors ...RequestEditorFn) (*ListLineagesResponse, error) {
+	rsp, err := c.ListLineages(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLineagesResponse(rsp)
+}
+
+// GetLineageStatsWithResponse request returning *GetLineageStatsResponse
+func (c *ClientWithResponses) GetLineageStatsWithResponse(ctx context.Context, params *GetLineageStatsParams, reqEditors ...RequestEditorFn) (*GetLineageStatsResponse, error) {
+	rsp, err := c.GetLineageStats(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLineageStatsResponse(rsp)
+}
+
+// StreamLineagesEventsWithResponse request returning *StreamLineagesEventsResponse
+func (c *ClientWithResponses) StreamLineagesEventsWithResponse(ctx context.Context, params *StreamLineagesEventsParams, reqEditors ...RequestEditorFn) (*StreamLineagesEventsResponse, error) {
+	rsp, err := c.StreamLineagesEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamLineagesEventsResponse(rsp)
+}
+
+// GetLineageWithResponse request returning *GetLineageResponse
+func (c *ClientWithResponses) GetLineageWithResponse(ctx context.Context, lineageId string, reqEditors ...RequestEditorFn) (*GetLineageResponse, error) {
+	rsp, err := c.GetLineage(ctx, lineageId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLineageResponse(rsp)
+}
+
+// UpdateLineageWithBodyWithResponse request with arbitrary body returning *UpdateLineageResponse
+func (c *ClientWithResponses) UpdateLineageWithBodyWithResponse(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLineageResponse, error) {
+	rsp, err := c.UpdateLineageWithBody(ctx, lineageId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLineageResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLineageWithResponse(ctx context.Context, lineageId string, body UpdateLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLineageResponse, error) {
+	rsp, err := c.UpdateLineage(ctx, lineageId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLineageResponse(rsp)
+}
+
+// CloneLineageWithBodyWithResponse request with arbitrary body returning *CloneLineageResponse
+func (c *ClientWithResponses) CloneLineageWithBodyWithResponse(ctx context.Context, lineageId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneLineageResponse, error) {
+	rsp, err := c.CloneLineageWithBody(ctx, lineageId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneLineageResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloneLineageWithResponse(ctx context.Context, lineageId string, body CloneLineageJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneLineageResponse, error) {
+	rsp, err := c.CloneLineage(ctx, lineageId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneLineageResponse(rsp)
+}
+
+// ListLineageEventsWithResponse request returning *ListLineageEventsResponse
+func (c *ClientWithResponses) ListLineageEventsWithResponse(ctx context.Context, lineageId string, params *ListLineageEventsParams, reqEditors ...RequestEditorFn) (*ListLineageEventsResponse, error) {
+	rsp, err := c.ListLineageEvents(ctx, lineageId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLineageEventsResponse(rsp)
+}
+
+// GetNonceWithResponse request returning *GetNonceResponse
+func (c *ClientWithResponses) GetNonceWithResponse(ctx context.Context, lineageId string, nonce int64, reqEditors ...RequestEditorFn) (*GetNonceResponse, error) {
+	rsp, err := c.GetNonce(ctx, lineageId, nonce, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNonceResponse(rsp)
+}
+
+// StreamLineageEventsWithResponse request returning *StreamLineageEventsResponse
+func (c *ClientWithResponses) StreamLineageEventsWithResponse(ctx context.Context, lineageId string, params *StreamLineageEventsParams, reqEditors ...RequestEditorFn) (*StreamLineageEventsResponse, error) {
+	rsp, err := c.StreamLineageEvents(ctx, lineageId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamLineageEventsResponse(rsp)
+}
+
+// GetTicketsWithResponse request returning *GetTicketsResponse
+func (c *ClientWithResponses) GetTicketsWithResponse(ctx context.Context, lineageId string, params *GetTicketsParams, reqEditors ...RequestEditorFn) (*GetTicketsResponse, error) {
+	rsp, err := c.GetTickets(ctx, lineageId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTicketsResponse(rsp)
+}
+
+// UpdateTicketsWithBodyWithResponse request with arbitrary body returning *UpdateTicketsResponse
+func (c *ClientWithResponses) UpdateTicketsWithBodyWithResponse(ctx context.Context, lineageId string, params *UpdateTicketsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTicketsResponse, error) {
+	rsp, err := c.UpdateTicketsWithBody(ctx, lineageId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTicketsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTicketsWithResponse(ctx context.Context, lineageId string, params *UpdateTicketsParams, body UpdateTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTicketsResponse, error) {
+	rsp, err := c.UpdateTickets(ctx, lineageId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTicketsResponse(rsp)
+}
+
+// LeaseTicketWithBodyWithResponse request with arbitrary body returning *LeaseTicketResponse
+func (c *ClientWithResponses) LeaseTicketWithBodyWithResponse(ctx context.Context, lineageId string, params *LeaseTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LeaseTicketResponse, error) {
+	rsp, err := c.LeaseTicketWithBody(ctx, lineageId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLeaseTicketResponse(rsp)
+}
+
+func (c *ClientWithResponses) LeaseTicketWithResponse(ctx context.Context, lineageId string, params *LeaseTicketParams, body LeaseTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*LeaseTicketResponse, error) {
+	rsp, err := c.LeaseTicket(ctx, lineageId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLeaseTicketResponse(rsp)
+}
+
+// GetTicketWithResponse request returning *GetTicketResponse
+func (c *ClientWithResponses) GetTicketWithResponse(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*GetTicketResponse, error) {
+	rsp, err := c.GetTicket(ctx, lineageId, ticketExtId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTicketResponse(rsp)
+}
+
+// UpdateTicketWithBodyWithResponse request with arbitrary body returning *UpdateTicketResponse
+func (c *ClientWithResponses) UpdateTicketWithBodyWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTicketResponse, error) {
+	rsp, err := c.UpdateTicketWithBody(ctx, lineageId, ticketExtId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTicketResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTicketWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *UpdateTicketParams, body UpdateTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTicketResponse, error) {
+	rsp, err := c.UpdateTicket(ctx, lineageId, ticketExtId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTicketResponse(rsp)
+}
+
+// GetTicketHistoryWithResponse request returning *GetTicketHistoryResponse
+func (c *ClientWithResponses) GetTicketHistoryWithResponse(ctx context.Context, lineageId string, ticketExtId string, reqEditors ...RequestEditorFn) (*GetTicketHistoryResponse, error) {
+	rsp, err := c.GetTicketHistory(ctx, lineageId, ticketExtId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTicketHistoryResponse(rsp)
+}
+
+// TransferTicketWithBodyWithResponse request with arbitrary body returning *TransferTicketResponse
+func (c *ClientWithResponses) TransferTicketWithBodyWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferTicketResponse, error) {
+	rsp, err := c.TransferTicketWithBody(ctx, lineageId, ticketExtId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferTicketResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransferTicketWithResponse(ctx context.Context, lineageId string, ticketExtId string, params *TransferTicketParams, body TransferTicketJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferTicketResponse, error) {
+	rsp, err := c.TransferTicket(ctx, lineageId, ticketExtId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferTicketResponse(rsp)
+}
+
+// ParseGetLineageByExtIdResponse parses an HTTP response from a GetLineageByExtIdWithResponse call
+func ParseGetLineageByExtIdResponse(rsp *http.Response) (*GetLineageByExtIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLineageByExtIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateLineageResponse parses an HTTP response from a CreateLineageWithResponse call
+func ParseCreateLineageResponse(rsp *http.Response) (*CreateLineageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateLineageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageCreationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLineageByAddressResponse parses an HTTP response from a GetLineageByAddressWithResponse call
+func ParseGetLineageByAddressResponse(rsp *http.Response) (*GetLineageByAddressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLineageByAddressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLineagesResponse parses an HTTP response from a ListLineagesWithResponse call
+func ParseListLineagesResponse(rsp *http.Response) (*ListLineagesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLineagesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLineageStatsResponse parses an HTTP response from a GetLineageStatsWithResponse call
+func ParseGetLineageStatsResponse(rsp *http.Response) (*GetLineageStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLineageStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageStatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamLineagesEventsResponse parses an HTTP response from a StreamLineagesEventsWithResponse call
+func ParseStreamLineagesEventsResponse(rsp *http.Response) (*StreamLineagesEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamLineagesEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLineageResponse parses an HTTP response from a GetLineageWithResponse call
+func ParseGetLineageResponse(rsp *http.Response) (*GetLineageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLineageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateLineageResponse parses an HTTP response from a UpdateLineageWithResponse call
+func ParseUpdateLineageResponse(rsp *http.Response) (*UpdateLineageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateLineageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloneLineageResponse parses an HTTP response from a CloneLineageWithResponse call
+func ParseCloneLineageResponse(rsp *http.Response) (*CloneLineageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloneLineageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLineageEventsResponse parses an HTTP response from a ListLineageEventsWithResponse call
+func ParseListLineageEventsResponse(rsp *http.Response) (*ListLineageEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLineageEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageEventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNonceResponse parses an HTTP response from a GetNonceWithResponse call
+func ParseGetNonceResponse(rsp *http.Response) (*GetNonceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNonceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NonceGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamLineageEventsResponse parses an HTTP response from a StreamLineageEventsWithResponse call
+func ParseStreamLineageEventsResponse(rsp *http.Response) (*StreamLineageEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamLineageEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTicketsResponse parses an HTTP response from a GetTicketsWithResponse call
+func ParseGetTicketsResponse(rsp *http.Response) (*GetTicketsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTicketsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TicketLeaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTicketsResponse parses an HTTP response from a UpdateTicketsWithResponse call
+func ParseUpdateTicketsResponse(rsp *http.Response) (*UpdateTicketsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTicketsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TicketBulkUpdateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLeaseTicketResponse parses an HTTP response from a LeaseTicketWithResponse call
+func ParseLeaseTicketResponse(rsp *http.Response) (*LeaseTicketResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LeaseTicketResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TicketLeaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTicketResponse parses an HTTP response from a GetTicketWithResponse call
+func ParseGetTicketResponse(rsp *http.Response) (*GetTicketResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTicketResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TicketLeaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTicketResponse parses an HTTP response from a UpdateTicketWithResponse call
+func ParseUpdateTicketResponse(rsp *http.Response) (*UpdateTicketResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTicketResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTicketHistoryResponse parses an HTTP response from a GetTicketHistoryWithResponse call
+func ParseGetTicketHistoryResponse(rsp *http.Response) (*GetTicketHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTicketHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TicketHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransferTicketResponse parses an HTTP response from a TransferTicketWithResponse call
+func ParseTransferTicketResponse(rsp *http.Response) (*TransferTicketResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferTicketResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TicketLeaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/welthee/dinonce/v2/pkg/client/openapi"
+)
+
+// LeaseTickets leases tickets of a lineage. The idempotency key, If-Match condition and wait of the lease are set
+// through params, which may be nil.
+func (c *Client) LeaseTickets(ctx context.Context, lineageId string, params *openapi.LeaseTicketParams,
+	request openapi.TicketLeaseRequest) (*openapi.TicketLeaseResponse, error) {
+
+	if params == nil {
+		params = &openapi.LeaseTicketParams{}
+	}
+
+	return do[openapi.TicketLeaseResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.LeaseTicket(ctx, lineageId, params, request)
+	})
+}
+
+// GetTicket returns the lease of a ticket.
+func (c *Client) GetTicket(ctx context.Context, lineageId string, ticketExtId string) (
+	*openapi.TicketLeaseResponse, error) {
+
+	return do[openapi.TicketLeaseResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetTicket(ctx, lineageId, ticketExtId)
+	})
+}
+
+// GetTickets returns the tickets with the given extIds, or lists the tickets of the lineage without them.
+func (c *Client) GetTickets(ctx context.Context, lineageId string, params *openapi.GetTicketsParams) (
+	*openapi.TicketLeaseResponse, error) {
+
+	return do[openapi.TicketLeaseResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetTickets(ctx, lineageId, params)
+	})
+}
+
+// ReleaseTicket releases a ticket, its nonce is leased again by the next lease of the lineage. params may be nil.
+func (c *Client) ReleaseTicket(ctx context.Context, lineageId string, ticketExtId string,
+	params *openapi.UpdateTicketParams) error {
+
+	return c.updateTicket(ctx, lineageId, ticketExtId, params, openapi.TicketUpdateRequestStateReleased)
+}
+
+// CloseTicket closes a ticket, its nonce is used for good. params may be nil.
+func (c *Client) CloseTicket(ctx context.Context, lineageId string, ticketExtId string,
+	params *openapi.UpdateTicketParams) error {
+
+	return c.updateTicket(ctx, lineageId, ticketExtId, params, openapi.TicketUpdateRequestStateClosed)
+}
+
+func (c *Client) updateTicket(ctx context.Context, lineageId string, ticketExtId string,
+	params *openapi.UpdateTicketParams, state openapi.TicketUpdateRequestState) error {
+
+	if params == nil {
+		params = &openapi.UpdateTicketParams{}
+	}
+
+	_, err := do[struct{}](ctx, c, func() (*http.Response, error) {
+		return c.api.UpdateTicket(ctx, lineageId, ticketExtId, params, openapi.TicketUpdateRequest{State: state})
+	})
+
+	return err
+}
+
+// UpdateTickets releases and closes many tickets of a lineage at once. params may be nil.
+func (c *Client) UpdateTickets(ctx context.Context, lineageId string, params *openapi.UpdateTicketsParams,
+	request openapi.TicketBulkUpdateRequest) (*openapi.TicketBulkUpdateResponse, error) {
+
+	if params == nil {
+		params = &openapi.UpdateTicketsParams{}
+	}
+
+	return do[openapi.TicketBulkUpdateResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.UpdateTickets(ctx, lineageId, params, request)
+	})
+}
+
+// TransferTicket moves the nonce of a leased ticket to a new ticket. params may be nil.
+func (c *Client) TransferTicket(ctx context.Context, lineageId string, ticketExtId string,
+	params *openapi.TransferTicketParams, request openapi.TicketTransferRequest) (*openapi.TicketLeaseResponse, error) {
+
+	if params == nil {
+		params = &openapi.TransferTicketParams{}
+	}
+
+	return do[openapi.TicketLeaseResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.TransferTicket(ctx, lineageId, ticketExtId, params, request)
+	})
+}
+
+// GetTicketHistory returns the state changes of a ticket.
+func (c *Client) GetTicketHistory(ctx context.Context, lineageId string, ticketExtId string) (
+	*openapi.TicketHistoryResponse, error) {
+
+	return do[openapi.TicketHistoryResponse](ctx, c, func() (*http.Response, error) {
+		return c.api.GetTicketHistory(ctx, lineageId, ticketExtId)
+	})
+}
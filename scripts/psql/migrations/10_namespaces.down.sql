@@ -0,0 +1,6 @@
+drop index if exists lineages_namespace_ext_id_idx;
+
+create unique index if not exists lineages_ext_id_idx on lineages (ext_id);
+
+alter table lineages
+    drop column if exists namespace;
@@ -0,0 +1,6 @@
+alter table lineages
+    add column if not exists namespace character varying(64) not null default 'default';
+
+drop index if exists lineages_ext_id_idx;
+
+create unique index if not exists lineages_namespace_ext_id_idx on lineages (namespace, ext_id);
@@ -0,0 +1,2 @@
+alter table lineages
+    drop column if exists start_leasing_from;
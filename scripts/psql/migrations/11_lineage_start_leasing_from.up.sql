@@ -0,0 +1,2 @@
+alter table lineages
+    add column if not exists start_leasing_from bigint;
@@ -0,0 +1 @@
+drop function if exists clone_lineage;
@@ -0,0 +1,7 @@
+drop index if exists lineages_namespace_chain_id_address_idx;
+
+alter table lineages
+    drop column if exists address;
+
+alter table lineages
+    drop column if exists chain_id;
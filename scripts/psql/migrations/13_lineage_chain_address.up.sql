@@ -0,0 +1,9 @@
+alter table lineages
+    add column if not exists chain_id bigint;
+
+alter table lineages
+    add column if not exists address character varying(64);
+
+create unique index if not exists lineages_namespace_chain_id_address_idx
+    on lineages (namespace, chain_id, address)
+    where chain_id is not null;
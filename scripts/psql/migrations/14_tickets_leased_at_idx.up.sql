@@ -0,0 +1 @@
+create index if not exists tickets_lineage_id_leased_at_nonce_idx on tickets (lineage_id, leased_at, nonce);
@@ -0,0 +1 @@
+drop function if exists update_tickets;
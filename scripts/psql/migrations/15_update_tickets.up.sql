@@ -0,0 +1,99 @@
+create or replace function update_tickets(
+    _lineage_id uuid,
+    _lineage_version bigint,
+    _ticket_ext_ids character varying(255)[],
+    _ticket_states character varying(16)[]
+) returns character varying(16)[]
+    language plpgsql
+as
+$$
+declare
+    _now                  timestamptz;
+    _newversion           bigint;
+    _nonce                bigint;
+    _ext_id               character varying(255);
+    _outcomes             character varying(16)[];
+    _released_nonce_count bigint;
+    _closed_nonce_count   bigint;
+begin
+    _now := now();
+    _outcomes := '{}';
+    _released_nonce_count := 0;
+    _closed_nonce_count := 0;
+
+    if array_length(_ticket_ext_ids, 1) is distinct from array_length(_ticket_states, 1) then
+        raise exception 'validation_error';
+    end if;
+
+    for i in 1 .. coalesce(array_length(_ticket_ext_ids, 1), 0)
+        loop
+            _nonce := null;
+            _ext_id := null;
+
+            if _ticket_states[i] = 'released' then
+                delete
+                from tickets
+                where lineage_id = _lineage_id
+                  and ext_id = _ticket_ext_ids[i]
+                  and lease_status = 'leased'
+                returning nonce into _nonce;
+
+                if _nonce is null then
+                    _outcomes := array_append(_outcomes, 'no_such_ticket');
+                    continue;
+                end if;
+
+                insert into released_tickets(lineage_id, nonce, released_at) values (_lineage_id, _nonce, _now);
+
+                _released_nonce_count := _released_nonce_count + 1;
+                _outcomes := array_append(_outcomes, 'released');
+            elsif _ticket_states[i] = 'closed' then
+                update tickets
+                set lease_status='closed'
+                where lineage_id = _lineage_id
+                  and ext_id = _ticket_ext_ids[i]
+                  and lease_status = 'leased'
+                returning ext_id into _ext_id;
+
+                if _ext_id is null then
+                    if exists(select 1
+                              from tickets
+                              where lineage_id = _lineage_id
+                                and ext_id = _ticket_ext_ids[i]
+                                and lease_status = 'closed') then
+                        _outcomes := array_append(_outcomes, 'already_closed');
+                    else
+                        _outcomes := array_append(_outcomes, 'no_such_ticket');
+                    end if;
+                    continue;
+                end if;
+
+                _closed_nonce_count := _closed_nonce_count + 1;
+                _outcomes := array_append(_outcomes, 'closed');
+            else
+                raise exception 'validation_error';
+            end if;
+        end loop;
+
+    --
+    -- every ticket of the request is covered by a single version bump, so concurrent ticket operations
+    -- on the lineage fail the optimistic lock once instead of once per ticket
+    --
+    if _released_nonce_count + _closed_nonce_count > 0 then
+        update lineages
+        set released_nonce_count = released_nonce_count + _released_nonce_count,
+            leased_nonce_count   = leased_nonce_count - _closed_nonce_count,
+            closed_nonce_count   = closed_nonce_count + _closed_nonce_count,
+            version              = version + 1
+        where id = _lineage_id
+          and version = _lineage_version
+        returning version into _newversion;
+
+        if _newversion is null then
+            raise exception 'optimistic_lock';
+        end if;
+    end if;
+
+    return _outcomes;
+end;
+$$;
@@ -0,0 +1 @@
+drop index if exists tickets_lineage_id_nonce_idx;
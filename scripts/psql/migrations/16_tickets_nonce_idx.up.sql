@@ -0,0 +1 @@
+create index if not exists tickets_lineage_id_nonce_idx on tickets (lineage_id, nonce);
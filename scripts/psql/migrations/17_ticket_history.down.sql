@@ -0,0 +1,3 @@
+drop trigger if exists tickets_history_trg on tickets;
+drop function if exists record_ticket_history;
+drop table if exists ticket_history;
@@ -0,0 +1,77 @@
+create table if not exists ticket_history
+(
+    id         bigserial,
+    lineage_id uuid                   not null,
+    ext_id     character varying(255) not null,
+    nonce      bigint                 not null,
+    action     character varying(16)  not null,
+    actor      character varying(255),
+    created_at timestamptz            not null default now(),
+    primary key (id),
+    constraint fk_lineage
+        foreign key (lineage_id)
+            references lineages (id)
+);
+
+create index if not exists ticket_history_lineage_id_ext_id_idx on ticket_history (lineage_id, ext_id, id);
+create index if not exists ticket_history_lineage_id_nonce_idx on ticket_history (lineage_id, nonce);
+
+--
+-- every ticket function runs with the dinonce.actor setting of its request, changes done on behalf of an admin
+-- additionally set dinonce.forced to true
+--
+create or replace function record_ticket_history() returns trigger
+    language plpgsql
+as
+$$
+declare
+    _actor  character varying(255);
+    _forced boolean;
+    _action character varying(16);
+begin
+    _actor := nullif(current_setting('dinonce.actor', true), '');
+    _forced := coalesce(current_setting('dinonce.forced', true), '') = 'true';
+
+    if tg_op = 'INSERT' then
+        if new.lease_status = 'closed' then
+            _action := 'closed';
+        elsif exists(select 1
+                     from ticket_history
+                     where lineage_id = new.lineage_id
+                       and nonce = new.nonce) then
+            _action := 're_leased';
+        else
+            _action := 'leased';
+        end if;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor);
+
+        return new;
+    elsif tg_op = 'UPDATE' then
+        if new.lease_status = old.lease_status then
+            return new;
+        end if;
+
+        _action := case when _forced then 'force_closed' else 'closed' end;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor);
+
+        return new;
+    else
+        _action := case when _forced then 'force_released' else 'released' end;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (old.lineage_id, old.ext_id, old.nonce, _action, _actor);
+
+        return old;
+    end if;
+end;
+$$;
+
+create trigger tickets_history_trg
+    after insert or update or delete
+    on tickets
+    for each row
+execute function record_ticket_history();
@@ -0,0 +1,10 @@
+drop trigger if exists lineages_updated_event_trg on lineages;
+drop trigger if exists lineages_created_event_trg on lineages;
+drop trigger if exists tickets_event_trg on tickets;
+drop function if exists record_lineage_event;
+drop function if exists record_ticket_event;
+drop function if exists append_lineage_event;
+drop table if exists lineage_events;
+
+alter table lineages
+    drop column if exists event_seq;
@@ -0,0 +1,101 @@
+alter table lineages
+    add column if not exists event_seq bigint not null default 0;
+
+create table if not exists lineage_events
+(
+    lineage_id uuid                  not null,
+    seq        bigint                not null,
+    type       character varying(32) not null,
+    ext_id     character varying(255),
+    nonce      bigint,
+    actor      character varying(255),
+    created_at timestamptz           not null default now(),
+    primary key (lineage_id, seq),
+    constraint fk_lineage
+        foreign key (lineage_id)
+            references lineages (id)
+);
+
+--
+-- sequence numbers are handed out under the row lock of the lineage, so they are gap free and events are
+-- visible in sequence order once their transaction commits
+--
+create or replace function append_lineage_event(
+    _lineage_id uuid,
+    _type character varying(32),
+    _ext_id character varying(255),
+    _nonce bigint
+) returns bigint
+    language plpgsql
+as
+$$
+declare
+    _seq bigint;
+begin
+    update lineages
+    set event_seq = event_seq + 1
+    where id = _lineage_id
+    returning event_seq into _seq;
+
+    insert into lineage_events(lineage_id, seq, type, ext_id, nonce, actor)
+    values (_lineage_id, _seq, _type, _ext_id, _nonce, nullif(current_setting('dinonce.actor', true), ''));
+
+    return _seq;
+end;
+$$;
+
+create or replace function record_ticket_event() returns trigger
+    language plpgsql
+as
+$$
+begin
+    if tg_op = 'INSERT' then
+        perform append_lineage_event(new.lineage_id,
+                                     case when new.lease_status = 'closed' then 'ticket_closed' else 'ticket_leased' end,
+                                     new.ext_id, new.nonce);
+        return new;
+    elsif tg_op = 'UPDATE' then
+        if new.lease_status <> old.lease_status then
+            perform append_lineage_event(new.lineage_id, 'ticket_closed', new.ext_id, new.nonce);
+        end if;
+        return new;
+    else
+        perform append_lineage_event(old.lineage_id, 'ticket_released', old.ext_id, old.nonce);
+        return old;
+    end if;
+end;
+$$;
+
+create or replace function record_lineage_event() returns trigger
+    language plpgsql
+as
+$$
+begin
+    if tg_op = 'INSERT' then
+        perform append_lineage_event(new.id, 'lineage_created', null, null);
+    else
+        perform append_lineage_event(new.id, 'lineage_updated', null, null);
+    end if;
+
+    return null;
+end;
+$$;
+
+create trigger tickets_event_trg
+    after insert or update or delete
+    on tickets
+    for each row
+execute function record_ticket_event();
+
+create trigger lineages_created_event_trg
+    after insert
+    on lineages
+    for each row
+execute function record_lineage_event();
+
+create trigger lineages_updated_event_trg
+    after update of labels
+    on lineages
+    for each row
+    when (old.labels is distinct from new.labels)
+execute function record_lineage_event();
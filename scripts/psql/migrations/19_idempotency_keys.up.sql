@@ -0,0 +1,17 @@
+create table if not exists idempotency_keys
+(
+    lineage_id   uuid                   not null,
+    key          character varying(255) not null,
+    fingerprint  character varying(64)  not null,
+    status_code  integer,
+    content_type character varying(255),
+    body         bytea,
+    created_at   timestamptz            not null default now(),
+    expires_at   timestamptz            not null,
+    primary key (lineage_id, key),
+    constraint fk_lineage
+        foreign key (lineage_id)
+            references lineages (id)
+);
+
+create index if not exists idempotency_keys_expires_at_idx on idempotency_keys (expires_at);
@@ -0,0 +1,14 @@
+-- enum values can not be dropped, the type is recreated without it. The nonces of replaced tickets are held by
+-- the tickets which replaced them, so the replaced tickets can be dropped.
+delete
+from tickets
+where lease_status = 'replaced';
+
+alter type ticket_lease_status rename to ticket_lease_status_old;
+
+create type ticket_lease_status as enum ('leased','released', 'closed');
+
+alter table tickets
+    alter column lease_status type ticket_lease_status using lease_status::text::ticket_lease_status;
+
+drop type ticket_lease_status_old;
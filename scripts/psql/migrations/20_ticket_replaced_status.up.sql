@@ -0,0 +1 @@
+alter type ticket_lease_status add value if not exists 'replaced';
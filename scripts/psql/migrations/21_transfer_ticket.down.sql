@@ -0,0 +1,73 @@
+drop function if exists transfer_ticket;
+
+create or replace function record_ticket_history() returns trigger
+    language plpgsql
+as
+$$
+declare
+    _actor  character varying(255);
+    _forced boolean;
+    _action character varying(16);
+begin
+    _actor := nullif(current_setting('dinonce.actor', true), '');
+    _forced := coalesce(current_setting('dinonce.forced', true), '') = 'true';
+
+    if tg_op = 'INSERT' then
+        if new.lease_status = 'closed' then
+            _action := 'closed';
+        elsif exists(select 1
+                     from ticket_history
+                     where lineage_id = new.lineage_id
+                       and nonce = new.nonce) then
+            _action := 're_leased';
+        else
+            _action := 'leased';
+        end if;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor);
+
+        return new;
+    elsif tg_op = 'UPDATE' then
+        if new.lease_status = old.lease_status then
+            return new;
+        end if;
+
+        _action := case when _forced then 'force_closed' else 'closed' end;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor);
+
+        return new;
+    else
+        _action := case when _forced then 'force_released' else 'released' end;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (old.lineage_id, old.ext_id, old.nonce, _action, _actor);
+
+        return old;
+    end if;
+end;
+$$;
+
+create or replace function record_ticket_event() returns trigger
+    language plpgsql
+as
+$$
+begin
+    if tg_op = 'INSERT' then
+        perform append_lineage_event(new.lineage_id,
+                                     case when new.lease_status = 'closed' then 'ticket_closed' else 'ticket_leased' end,
+                                     new.ext_id, new.nonce);
+        return new;
+    elsif tg_op = 'UPDATE' then
+        if new.lease_status <> old.lease_status then
+            perform append_lineage_event(new.lineage_id, 'ticket_closed', new.ext_id, new.nonce);
+        end if;
+        return new;
+    else
+        perform append_lineage_event(old.lineage_id, 'ticket_released', old.ext_id, old.nonce);
+        return old;
+    end if;
+end;
+$$;
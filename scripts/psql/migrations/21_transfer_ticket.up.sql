@@ -0,0 +1,161 @@
+create or replace function transfer_ticket(
+    _lineage_id uuid,
+    _lineage_version bigint,
+    _ticket_ext_id character varying(255),
+    _new_ticket_ext_id character varying(255)
+) returns bigint
+    language plpgsql
+as
+$$
+declare
+    _nonce      bigint;
+    _leased_at  timestamptz;
+    _status     ticket_lease_status;
+    _newversion bigint;
+begin
+    select nonce, lease_status
+    into _nonce, _status
+    from tickets
+    where lineage_id = _lineage_id
+      and ext_id = _ticket_ext_id;
+
+    if _nonce is null then
+        raise exception 'no_such_ticket';
+    end if;
+
+    --
+    -- a retried transfer which was already applied returns the transferred nonce
+    --
+    if _status = 'replaced' and exists(select 1
+                                       from tickets
+                                       where lineage_id = _lineage_id
+                                         and ext_id = _new_ticket_ext_id
+                                         and nonce = _nonce
+                                         and lease_status = 'leased') then
+        return _nonce;
+    end if;
+
+    if _status <> 'leased' or _ticket_ext_id = _new_ticket_ext_id then
+        raise exception 'validation_error';
+    end if;
+
+    if exists(select 1
+              from tickets
+              where lineage_id = _lineage_id
+                and ext_id = _new_ticket_ext_id) then
+        raise exception 'validation_error';
+    end if;
+
+    update tickets
+    set lease_status = 'replaced'
+    where lineage_id = _lineage_id
+      and ext_id = _ticket_ext_id
+      and lease_status = 'leased'
+    returning leased_at into _leased_at;
+
+    perform set_config('dinonce.transferred_from', _ticket_ext_id, true);
+
+    insert into tickets(lineage_id, ext_id, nonce, leased_at, lease_status)
+    values (_lineage_id, _new_ticket_ext_id, _nonce, _leased_at, 'leased');
+
+    perform set_config('dinonce.transferred_from', '', true);
+
+    update lineages
+    set version = version + 1
+    where id = _lineage_id
+      and version = _lineage_version
+    returning version into _newversion;
+
+    if _newversion is null then
+        raise exception 'optimistic_lock';
+    end if;
+
+    return _nonce;
+end;
+$$;
+
+create or replace function record_ticket_history() returns trigger
+    language plpgsql
+as
+$$
+declare
+    _actor  character varying(255);
+    _forced boolean;
+    _action character varying(16);
+begin
+    _actor := nullif(current_setting('dinonce.actor', true), '');
+    _forced := coalesce(current_setting('dinonce.forced', true), '') = 'true';
+
+    if tg_op = 'INSERT' then
+        if new.lease_status = 'closed' then
+            _action := 'closed';
+        elsif coalesce(current_setting('dinonce.transferred_from', true), '') <> '' then
+            _action := 'transferred';
+        elsif exists(select 1
+                     from ticket_history
+                     where lineage_id = new.lineage_id
+                       and nonce = new.nonce) then
+            _action := 're_leased';
+        else
+            _action := 'leased';
+        end if;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor);
+
+        return new;
+    elsif tg_op = 'UPDATE' then
+        if new.lease_status = old.lease_status then
+            return new;
+        end if;
+
+        if new.lease_status = 'replaced' then
+            _action := 'replaced';
+        else
+            _action := case when _forced then 'force_closed' else 'closed' end;
+        end if;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor);
+
+        return new;
+    else
+        _action := case when _forced then 'force_released' else 'released' end;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor)
+        values (old.lineage_id, old.ext_id, old.nonce, _action, _actor);
+
+        return old;
+    end if;
+end;
+$$;
+
+create or replace function record_ticket_event() returns trigger
+    language plpgsql
+as
+$$
+begin
+    if tg_op = 'INSERT' then
+        perform append_lineage_event(new.lineage_id,
+                                     case
+                                         when new.lease_status = 'closed' then 'ticket_closed'
+                                         when coalesce(current_setting('dinonce.transferred_from', true), '') <> ''
+                                             then 'ticket_transferred'
+                                         else 'ticket_leased' end,
+                                     new.ext_id, new.nonce);
+        return new;
+    elsif tg_op = 'UPDATE' then
+        if new.lease_status <> old.lease_status then
+            perform append_lineage_event(new.lineage_id,
+                                         case
+                                             when new.lease_status = 'replaced' then 'ticket_replaced'
+                                             else 'ticket_closed' end,
+                                         new.ext_id, new.nonce);
+        end if;
+        return new;
+    else
+        perform append_lineage_event(old.lineage_id, 'ticket_released', old.ext_id, old.nonce);
+        return old;
+    end if;
+end;
+$$;
@@ -0,0 +1 @@
+drop function if exists create_contiguous_tickets;
@@ -0,0 +1,85 @@
+create or replace function create_contiguous_tickets(
+    _lineage_id uuid,
+    _lineage_version bigint,
+    _ticket_ext_ids character varying(255)[]
+) returns bigint[]
+    language plpgsql
+as
+$$
+declare
+    _now                      timestamptz;
+    _number_of_tickets        integer;
+    _existing_nonces          bigint[];
+    _number_of_existing       integer;
+    _next_nonce               bigint;
+    _number_of_leased_tickets integer;
+    _max_leased_unused_count  integer;
+begin
+    _now := now();
+    _number_of_tickets := array_length(_ticket_ext_ids, 1);
+
+    if _number_of_tickets is null
+        or (select count(distinct ext_id) from unnest(_ticket_ext_ids) as ext_id) <> _number_of_tickets then
+        raise exception 'validation_error';
+    end if;
+
+    select count(*)
+    into _number_of_existing
+    from tickets
+    where lineage_id = _lineage_id
+      and ext_id = any (_ticket_ext_ids);
+
+    --
+    -- a retried request gets its range back, any other overlap with existing tickets can not be served
+    -- as one contiguous range
+    --
+    if _number_of_existing > 0 then
+        select array(select t.nonce
+                     from unnest(_ticket_ext_ids) with ordinality as u(ext_id, i)
+                              join tickets t on t.lineage_id = _lineage_id
+                         and t.ext_id = u.ext_id
+                         and t.lease_status = 'leased'
+                     order by u.i)
+        into _existing_nonces;
+
+        if coalesce(array_length(_existing_nonces, 1), 0) = _number_of_tickets
+            and not exists(select 1
+                           from unnest(_existing_nonces) with ordinality as e(nonce, i)
+                           where e.nonce <> _existing_nonces[1] + e.i - 1) then
+            return _existing_nonces;
+        end if;
+
+        raise exception 'not_contiguous';
+    end if;
+
+    --
+    -- released nonces are holes below the next nonce, transactions using a fresh range would wait for them
+    --
+    if exists(select 1 from released_tickets where lineage_id = _lineage_id) then
+        raise exception 'not_contiguous';
+    end if;
+
+    update lineages
+    set next_nonce         = next_nonce + _number_of_tickets,
+        leased_nonce_count = leased_nonce_count + _number_of_tickets,
+        version            = version + 1
+    where id = _lineage_id
+      and version = _lineage_version
+    returning next_nonce, leased_nonce_count, max_leased_nonce_count
+        into _next_nonce, _number_of_leased_tickets, _max_leased_unused_count;
+
+    if _next_nonce is null then
+        raise exception 'optimistic_lock';
+    end if;
+
+    if _number_of_leased_tickets > _max_leased_unused_count then
+        raise exception 'max_unused_limit_exceeded';
+    end if;
+
+    insert into tickets(lineage_id, ext_id, nonce, leased_at, lease_status)
+    select _lineage_id, u.ext_id, _next_nonce - _number_of_tickets + u.i - 1, _now, 'leased'
+    from unnest(_ticket_ext_ids) with ordinality as u(ext_id, i);
+
+    return array(select generate_series(_next_nonce - _number_of_tickets, _next_nonce - 1));
+end;
+$$;
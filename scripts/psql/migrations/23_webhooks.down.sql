@@ -0,0 +1,11 @@
+drop trigger if exists lineages_limit_event_trg on lineages;
+drop function if exists record_lineage_limit_event;
+drop trigger if exists lineage_events_webhook_trg on lineage_events;
+drop function if exists enqueue_webhook_deliveries;
+drop function if exists labels_match;
+drop table if exists webhook_deliveries;
+drop table if exists webhooks;
+
+delete
+from lineage_events
+where type = 'lineage_limit_approaching';
@@ -0,0 +1,109 @@
+create table if not exists webhooks
+(
+    id                 uuid                   not null,
+    namespace          character varying(64)  not null,
+    url                text                   not null,
+    secret             character varying(255) not null,
+    event_types        character varying(32)[] not null default '{}',
+    label_selector     text                   not null default '',
+    label_requirements jsonb                  not null default '[]',
+    ext_id_prefix      character varying(64)  not null default '',
+    created_at         timestamptz            not null default now(),
+    primary key (id)
+);
+
+create index if not exists webhooks_namespace_idx on webhooks (namespace);
+
+create table if not exists webhook_deliveries
+(
+    id               bigserial,
+    webhook_id       uuid                  not null,
+    lineage_id       uuid                  not null,
+    seq              bigint                not null,
+    status           character varying(16) not null default 'pending',
+    attempts         integer               not null default 0,
+    next_attempt_at  timestamptz           not null default now(),
+    last_status_code integer,
+    last_error       text,
+    created_at       timestamptz           not null default now(),
+    delivered_at     timestamptz,
+    primary key (id),
+    constraint fk_webhook
+        foreign key (webhook_id)
+            references webhooks (id)
+            on delete cascade,
+    constraint fk_lineage_event
+        foreign key (lineage_id, seq)
+            references lineage_events (lineage_id, seq)
+);
+
+create index if not exists webhook_deliveries_pending_idx on webhook_deliveries (next_attempt_at)
+    where status = 'pending';
+create index if not exists webhook_deliveries_webhook_status_idx on webhook_deliveries (webhook_id, status);
+
+--
+-- label requirements are the parsed form of a label selector, see ticket.LabelSelector
+--
+create or replace function labels_match(
+    _labels jsonb,
+    _requirements jsonb
+) returns boolean
+    language sql
+    immutable
+as
+$$
+select coalesce(bool_and(case r ->> 'operator'
+                             when '=' then coalesce(_labels, '{}') @> jsonb_build_object(r ->> 'key', r ->> 'value')
+                             when '!=' then not coalesce(_labels, '{}') @>
+                                                jsonb_build_object(r ->> 'key', r ->> 'value')
+                             when 'exists' then coalesce(_labels, '{}') ? (r ->> 'key')
+                             when '!' then not coalesce(_labels, '{}') ? (r ->> 'key')
+    end), true)
+from jsonb_array_elements(_requirements) r;
+$$;
+
+create or replace function enqueue_webhook_deliveries() returns trigger
+    language plpgsql
+as
+$$
+begin
+    insert into webhook_deliveries(webhook_id, lineage_id, seq)
+    select w.id, new.lineage_id, new.seq
+    from webhooks w
+             join lineages l on l.id = new.lineage_id and l.namespace = w.namespace
+    where (cardinality(w.event_types) = 0 or new.type = any (w.event_types))
+      and starts_with(l.ext_id, w.ext_id_prefix)
+      and labels_match(l.labels, w.label_requirements);
+
+    return null;
+end;
+$$;
+
+create trigger lineage_events_webhook_trg
+    after insert
+    on lineage_events
+    for each row
+execute function enqueue_webhook_deliveries();
+
+--
+-- a lineage approaches its limit once 90% of its max_leased_nonce_count is in use, released nonces waiting to be
+-- leased again are not in use
+--
+create or replace function record_lineage_limit_event() returns trigger
+    language plpgsql
+as
+$$
+begin
+    perform append_lineage_event(new.id, 'lineage_limit_approaching', null, null);
+
+    return null;
+end;
+$$;
+
+create trigger lineages_limit_event_trg
+    after update of leased_nonce_count, released_nonce_count
+    on lineages
+    for each row
+    when ((new.leased_nonce_count - new.released_nonce_count) * 10 >= new.max_leased_nonce_count * 9
+        and (old.leased_nonce_count - old.released_nonce_count) * 10 < old.max_leased_nonce_count * 9)
+execute function record_lineage_limit_event();
@@ -0,0 +1,12 @@
+drop function if exists reset_lineage;
+drop function if exists repair_lineage;
+drop function if exists recount_lineage;
+drop function if exists set_lineage_paused;
+drop function if exists force_close_ticket;
+drop function if exists force_release_ticket;
+drop function if exists lock_admin_lineage;
+drop trigger if exists tickets_paused_lease_trg on tickets;
+drop function if exists reject_paused_lease;
+
+alter table lineages
+    drop column if exists paused;
@@ -0,0 +1,253 @@
+alter table lineages
+    add column if not exists paused boolean not null default false;
+
+--
+-- leasing inserts a ticket for every nonce it hands out, transferring a ticket inserts its replacement which is
+-- not a new lease
+--
+create or replace function reject_paused_lease() returns trigger
+    language plpgsql
+as
+$$
+begin
+    if new.lease_status = 'leased'
+        and coalesce(current_setting('dinonce.transferred_from', true), '') = ''
+        and exists(select 1 from lineages where id = new.lineage_id and paused) then
+        raise exception 'lineage_paused';
+    end if;
+
+    return new;
+end;
+$$;
+
+create trigger tickets_paused_lease_trg
+    before insert
+    on tickets
+    for each row
+execute function reject_paused_lease();
+
+--
+-- the admin functions run with dinonce.forced set to true and lock the lineage instead of checking its version,
+-- bumping the version fails the optimistic lock of concurrent ticket functions
+--
+create or replace function lock_admin_lineage(
+    _namespace character varying,
+    _lineage_id uuid
+) returns void
+    language plpgsql
+as
+$$
+begin
+    perform 1
+    from lineages
+    where id = _lineage_id
+      and namespace = _namespace
+        for update;
+
+    if not found then
+        raise exception 'no_such_lineage';
+    end if;
+end;
+$$;
+
+create or replace function force_release_ticket(
+    _namespace character varying,
+    _lineage_id uuid,
+    _ticket_ext_id character varying(255)
+) returns bigint
+    language plpgsql
+as
+$$
+declare
+    _nonce  bigint;
+    _status ticket_lease_status;
+begin
+    perform lock_admin_lineage(_namespace, _lineage_id);
+
+    delete
+    from tickets
+    where lineage_id = _lineage_id
+      and ext_id = _ticket_ext_id
+      and lease_status in ('leased', 'closed')
+    returning nonce, lease_status into _nonce, _status;
+
+    if _nonce is null then
+        raise exception 'no_such_ticket';
+    end if;
+
+    insert into released_tickets(lineage_id, nonce, released_at) values (_lineage_id, _nonce, now());
+
+    -- a closed nonce counts as leased again until the released nonce is leased and closed anew
+    update lineages
+    set released_nonce_count = released_nonce_count + 1,
+        leased_nonce_count   = leased_nonce_count + case when _status = 'closed' then 1 else 0 end,
+        closed_nonce_count   = closed_nonce_count - case when _status = 'closed' then 1 else 0 end,
+        version              = version + 1
+    where id = _lineage_id;
+
+    return _nonce;
+end;
+$$;
+
+create or replace function force_close_ticket(
+    _namespace character varying,
+    _lineage_id uuid,
+    _ticket_ext_id character varying(255)
+) returns bigint
+    language plpgsql
+as
+$$
+declare
+    _nonce bigint;
+begin
+    perform lock_admin_lineage(_namespace, _lineage_id);
+
+    update tickets
+    set lease_status='closed'
+    where lineage_id = _lineage_id
+      and ext_id = _ticket_ext_id
+      and lease_status = 'leased'
+    returning nonce into _nonce;
+
+    if _nonce is null then
+        select nonce
+        into _nonce
+        from tickets
+        where lineage_id = _lineage_id
+          and ext_id = _ticket_ext_id
+          and lease_status = 'closed';
+
+        if _nonce is null then
+            raise exception 'no_such_ticket';
+        end if;
+
+        return _nonce;
+    end if;
+
+    update lineages
+    set leased_nonce_count = leased_nonce_count - 1,
+        closed_nonce_count = closed_nonce_count + 1,
+        version            = version + 1
+    where id = _lineage_id;
+
+    return _nonce;
+end;
+$$;
+
+create or replace function set_lineage_paused(
+    _namespace character varying,
+    _lineage_id uuid,
+    _paused boolean
+) returns void
+    language plpgsql
+as
+$$
+begin
+    perform lock_admin_lineage(_namespace, _lineage_id);
+
+    update lineages
+    set paused  = _paused,
+        version = version + 1
+    where id = _lineage_id
+      and paused <> _paused;
+
+    if found then
+        perform append_lineage_event(_lineage_id, 'lineage_updated', null, null);
+    end if;
+end;
+$$;
+
+--
+-- the counters are derived from the tickets and released nonces of the lineage: every nonce handed out and not closed
+-- counts as leased, including the released ones
+--
+create or replace function recount_lineage(
+    _lineage_id uuid
+) returns void
+    language plpgsql
+as
+$$
+declare
+    _leased   bigint;
+    _released bigint;
+    _closed   bigint;
+begin
+    select count(*) filter (where lease_status = 'leased'), count(*) filter (where lease_status = 'closed')
+    into _leased, _closed
+    from tickets
+    where lineage_id = _lineage_id;
+
+    select count(*)
+    into _released
+    from released_tickets
+    where lineage_id = _lineage_id;
+
+    update lineages
+    set leased_nonce_count   = _leased + _released,
+        released_nonce_count = _released,
+        closed_nonce_count   = _closed,
+        version              = version + 1
+    where id = _lineage_id
+      and (leased_nonce_count, released_nonce_count, closed_nonce_count) is distinct from
+          (_leased + _released, _released, _closed);
+end;
+$$;
+
+create or replace function repair_lineage(
+    _namespace character varying,
+    _lineage_id uuid
+) returns bigint[]
+    language plpgsql
+as
+$$
+declare
+    _before bigint[];
+begin
+    perform lock_admin_lineage(_namespace, _lineage_id);
+
+    select array [leased_nonce_count, released_nonce_count, closed_nonce_count]
+    into _before
+    from lineages
+    where id = _lineage_id;
+
+    perform recount_lineage(_lineage_id);
+
+    return _before;
+end;
+$$;
+
+--
+-- every nonce below _next_nonce is used by the account: released nonces can never be used again and leased tickets
+-- below it are left to be closed. Tickets from _next_nonce on were never used, they are released and their nonces
+-- handed out again in order.
+--
+create or replace function reset_lineage(
+    _namespace character varying,
+    _lineage_id uuid,
+    _next_nonce bigint
+) returns void
+    language plpgsql
+as
+$$
+begin
+    perform lock_admin_lineage(_namespace, _lineage_id);
+
+    delete
+    from released_tickets
+    where lineage_id = _lineage_id;
+
+    delete
+    from tickets
+    where lineage_id = _lineage_id
+      and nonce >= _next_nonce
+      and lease_status in ('leased', 'closed');
+
+    update lineages
+    set next_nonce = _next_nonce,
+        version    = version + 1
+    where id = _lineage_id;
+
+    perform recount_lineage(_lineage_id);
+    perform append_lineage_event(_lineage_id, 'lineage_updated', null, null);
+end;
+$$;
@@ -0,0 +1,127 @@
+--
+-- counting the lineages of a namespace does not lock anything, so concurrent creations and clones would all see the
+-- namespace below its limit. They take a transaction scoped advisory lock on the namespace first, serializing the
+-- creations of a namespace with a limit until they commit.
+--
+create or replace function namespace_lineage_limit_reached(
+    _namespace character varying(64),
+    _max_lineage_count integer
+) returns boolean
+    language plpgsql
+as
+$$
+begin
+    if _max_lineage_count is null then
+        return false;
+    end if;
+
+    perform pg_advisory_xact_lock(hashtext(_namespace));
+
+    return (select count(*) from lineages where namespace = _namespace) >= _max_lineage_count;
+end;
+$$;
+
+--
+-- returns null if no lineage was created, either because a lineage with the ext id already exists in the namespace
+-- or because the namespace is full
+--
+create or replace function create_lineage(
+    _namespace character varying(64),
+    _lineage_id uuid,
+    _lineage_ext_id character varying(255),
+    _start_leasing_from bigint,
+    _max_leased_nonce_count smallint,
+    _labels jsonb,
+    _chain_id bigint,
+    _address character varying(64),
+    _max_lineage_count integer
+) returns uuid
+    language plpgsql
+as
+$$
+declare
+    _id uuid;
+begin
+    if namespace_lineage_limit_reached(_namespace, _max_lineage_count) then
+        return null;
+    end if;
+
+    insert into lineages(id, ext_id, next_nonce, leased_nonce_count, released_nonce_count, closed_nonce_count,
+                         max_leased_nonce_count, max_nonce_value, version, created_at, labels, namespace,
+                         start_leasing_from, chain_id, address)
+    values (_lineage_id, _lineage_ext_id, _start_leasing_from, 0, 0, 0, _max_leased_nonce_count,
+            9223372036854775807, 0, now(), _labels, _namespace, _start_leasing_from, _chain_id, _address)
+    on conflict (namespace, ext_id) do nothing
+    returning id into _id;
+
+    return _id;
+end;
+$$;
+
+create or replace function clone_lineage(
+    _source_lineage_id uuid,
+    _namespace character varying(64),
+    _lineage_id uuid,
+    _lineage_ext_id character varying(255),
+    _labels jsonb,
+    _include_tickets boolean,
+    _max_lineage_count integer
+) returns void
+    language plpgsql
+as
+$$
+declare
+    _now timestamptz;
+begin
+    _now := now();
+
+    --
+    -- holding a share lock on the source lineage blocks every ticket operation on it from committing,
+    -- so the copied counters, released nonces and tickets are consistent with each other
+    --
+    perform 1
+    from lineages
+    where id = _source_lineage_id
+      and namespace = _namespace
+        for share;
+
+    if not found then
+        raise exception 'no_such_lineage';
+    end if;
+
+    if namespace_lineage_limit_reached(_namespace, _max_lineage_count) then
+        raise exception 'namespace_limit_exceeded';
+    end if;
+
+    insert into lineages(id, ext_id, namespace, next_nonce, leased_nonce_count, released_nonce_count,
+                         closed_nonce_count, max_leased_nonce_count, max_nonce_value, version, created_at, labels,
+                         start_leasing_from)
+    select _lineage_id,
+           _lineage_ext_id,
+           namespace,
+           next_nonce,
+           leased_nonce_count,
+           released_nonce_count,
+           closed_nonce_count,
+           max_leased_nonce_count,
+           max_nonce_value,
+           0,
+           _now,
+           coalesce(_labels, labels),
+           start_leasing_from
+    from lineages
+    where id = _source_lineage_id;
+
+    insert into released_tickets(lineage_id, nonce, released_at)
+    select _lineage_id, nonce, released_at
+    from released_tickets
+    where lineage_id = _source_lineage_id;
+
+    if _include_tickets then
+        insert into tickets(lineage_id, ext_id, nonce, leased_at, lease_status)
+        select _lineage_id, ext_id, nonce, leased_at, lease_status
+        from tickets
+        where lineage_id = _source_lineage_id;
+    end if;
+end;
+$$;
@@ -0,0 +1,67 @@
+create or replace function clone_lineage(
+    _source_lineage_id uuid,
+    _namespace character varying(64),
+    _lineage_id uuid,
+    _lineage_ext_id character varying(255),
+    _labels jsonb,
+    _include_tickets boolean,
+    _max_lineage_count integer
+) returns void
+    language plpgsql
+as
+$$
+declare
+    _now timestamptz;
+begin
+    _now := now();
+
+    --
+    -- holding a share lock on the source lineage blocks every ticket operation on it from committing,
+    -- so the copied counters, released nonces and tickets are consistent with each other
+    --
+    perform 1
+    from lineages
+    where id = _source_lineage_id
+      and namespace = _namespace
+        for share;
+
+    if not found then
+        raise exception 'no_such_lineage';
+    end if;
+
+    if namespace_lineage_limit_reached(_namespace, _max_lineage_count) then
+        raise exception 'namespace_limit_exceeded';
+    end if;
+
+    insert into lineages(id, ext_id, namespace, next_nonce, leased_nonce_count, released_nonce_count,
+                         closed_nonce_count, max_leased_nonce_count, max_nonce_value, version, created_at, labels,
+                         start_leasing_from)
+    select _lineage_id,
+           _lineage_ext_id,
+           namespace,
+           next_nonce,
+           leased_nonce_count,
+           released_nonce_count,
+           closed_nonce_count,
+           max_leased_nonce_count,
+           max_nonce_value,
+           0,
+           _now,
+           coalesce(_labels, labels),
+           start_leasing_from
+    from lineages
+    where id = _source_lineage_id;
+
+    insert into released_tickets(lineage_id, nonce, released_at)
+    select _lineage_id, nonce, released_at
+    from released_tickets
+    where lineage_id = _source_lineage_id;
+
+    if _include_tickets then
+        insert into tickets(lineage_id, ext_id, nonce, leased_at, lease_status)
+        select _lineage_id, ext_id, nonce, leased_at, lease_status
+        from tickets
+        where lineage_id = _source_lineage_id;
+    end if;
+end;
+$$;
@@ -0,0 +1,64 @@
+--
+-- the actor of a change is asserted by the caller, the principal is derived from the API key of the request and
+-- set as dinonce.principal next to dinonce.actor
+--
+alter table ticket_history
+    add column if not exists principal character varying(64);
+
+create or replace function record_ticket_history() returns trigger
+    language plpgsql
+as
+$$
+declare
+    _actor     character varying(255);
+    _principal character varying(64);
+    _forced    boolean;
+    _action    character varying(16);
+begin
+    _actor := nullif(current_setting('dinonce.actor', true), '');
+    _principal := nullif(current_setting('dinonce.principal', true), '');
+    _forced := coalesce(current_setting('dinonce.forced', true), '') = 'true';
+
+    if tg_op = 'INSERT' then
+        if new.lease_status = 'closed' then
+            _action := 'closed';
+        elsif coalesce(current_setting('dinonce.transferred_from', true), '') <> '' then
+            _action := 'transferred';
+        elsif exists(select 1
+                     from ticket_history
+                     where lineage_id = new.lineage_id
+                       and nonce = new.nonce) then
+            _action := 're_leased';
+        else
+            _action := 'leased';
+        end if;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor, principal)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor, _principal);
+
+        return new;
+    elsif tg_op = 'UPDATE' then
+        if new.lease_status = old.lease_status then
+            return new;
+        end if;
+
+        if new.lease_status = 'replaced' then
+            _action := 'replaced';
+        else
+            _action := case when _forced then 'force_closed' else 'closed' end;
+        end if;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor, principal)
+        values (new.lineage_id, new.ext_id, new.nonce, _action, _actor, _principal);
+
+        return new;
+    else
+        _action := case when _forced then 'force_released' else 'released' end;
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor, principal)
+        values (old.lineage_id, old.ext_id, old.nonce, _action, _actor, _principal);
+
+        return old;
+    end if;
+end;
+$$;
@@ -0,0 +1,7 @@
+drop index if exists lineage_events_txid_id_idx;
+
+alter table lineage_events
+    drop column if exists txid;
+
+alter table lineage_events
+    drop column if exists id;
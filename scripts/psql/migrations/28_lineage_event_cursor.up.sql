@@ -0,0 +1,15 @@
+--
+-- events are ordered across lineages by the transaction which recorded them and their id. Transactions commit out of
+-- order, so readers only return the events of transactions older than every running one, see
+-- txid_snapshot_xmin. Events recorded before the columns existed sort first, in id order.
+--
+alter table lineage_events
+    add column if not exists id bigserial;
+
+alter table lineage_events
+    add column if not exists txid bigint not null default 0;
+
+alter table lineage_events
+    alter column txid set default txid_current();
+
+create index if not exists lineage_events_txid_id_idx on lineage_events (txid, id);
@@ -0,0 +1,2 @@
+drop index if exists tickets_lineage_id_leased_at_nonce_ext_id_idx;
+create index if not exists tickets_lineage_id_leased_at_nonce_idx on tickets (lineage_id, leased_at, nonce);
@@ -0,0 +1,2 @@
+drop trigger if exists lineage_events_notify_trg on lineage_events;
+drop function if exists notify_lineage_events;
@@ -0,0 +1,26 @@
+--
+-- streams are woken by a notification of every transaction recording lineage events instead of polling for them.
+-- Notifications are sent once the transaction commits and carry its id, so a stream knows which events to wait for
+-- while older transactions are still running.
+--
+create or replace function notify_lineage_events() returns trigger
+    language plpgsql
+as
+$$
+begin
+    perform pg_notify('lineage_events', json_build_object('txId', txid_current(),
+                                                          'lineageId', e.lineage_id,
+                                                          'namespace', l.namespace)::text)
+    from (select distinct lineage_id from new_events) e
+             join lineages l on l.id = e.lineage_id;
+
+    return null;
+end;
+$$;
+
+create trigger lineage_events_notify_trg
+    after insert
+    on lineage_events
+    referencing new table as new_events
+    for each statement
+execute function notify_lineage_events();
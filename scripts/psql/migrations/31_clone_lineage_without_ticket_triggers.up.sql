@@ -0,0 +1,99 @@
+--
+-- copying the tickets of a lineage into its clone is not a change of those tickets: it records neither ticket history
+-- nor ticket events, and so queues no webhook deliveries. The clone takes over the history of the source instead, its
+-- own lineage_created event marks the clone.
+--
+drop trigger if exists tickets_history_trg on tickets;
+drop trigger if exists tickets_event_trg on tickets;
+
+create trigger tickets_history_trg
+    after insert or update or delete
+    on tickets
+    for each row
+    when (coalesce(current_setting('dinonce.cloning', true), '') = '')
+execute function record_ticket_history();
+
+create trigger tickets_event_trg
+    after insert or update or delete
+    on tickets
+    for each row
+    when (coalesce(current_setting('dinonce.cloning', true), '') = '')
+execute function record_ticket_event();
+
+create or replace function clone_lineage(
+    _source_lineage_id uuid,
+    _namespace character varying(64),
+    _lineage_id uuid,
+    _lineage_ext_id character varying(255),
+    _labels jsonb,
+    _include_tickets boolean,
+    _max_lineage_count integer
+) returns void
+    language plpgsql
+as
+$$
+declare
+    _now timestamptz;
+begin
+    _now := now();
+
+    --
+    -- holding a share lock on the source lineage blocks every ticket operation on it from committing,
+    -- so the copied counters, released nonces and tickets are consistent with each other
+    --
+    perform 1
+    from lineages
+    where id = _source_lineage_id
+      and namespace = _namespace
+        for share;
+
+    if not found then
+        raise exception 'no_such_lineage';
+    end if;
+
+    if namespace_lineage_limit_reached(_namespace, _max_lineage_count) then
+        raise exception 'namespace_limit_exceeded';
+    end if;
+
+    insert into lineages(id, ext_id, namespace, next_nonce, leased_nonce_count, released_nonce_count,
+                         closed_nonce_count, max_leased_nonce_count, max_nonce_value, version, created_at, labels,
+                         start_leasing_from)
+    select _lineage_id,
+           _lineage_ext_id,
+           namespace,
+           next_nonce,
+           case when _include_tickets then leased_nonce_count else released_nonce_count end,
+           released_nonce_count,
+           case when _include_tickets then closed_nonce_count else 0 end,
+           max_leased_nonce_count,
+           max_nonce_value,
+           0,
+           _now,
+           coalesce(_labels, labels),
+           start_leasing_from
+    from lineages
+    where id = _source_lineage_id;
+
+    insert into released_tickets(lineage_id, nonce, released_at)
+    select _lineage_id, nonce, released_at
+    from released_tickets
+    where lineage_id = _source_lineage_id;
+
+    if _include_tickets then
+        perform set_config('dinonce.cloning', 'true', true);
+
+        insert into tickets(lineage_id, ext_id, nonce, leased_at, lease_status)
+        select _lineage_id, ext_id, nonce, leased_at, lease_status
+        from tickets
+        where lineage_id = _source_lineage_id;
+
+        perform set_config('dinonce.cloning', '', true);
+
+        insert into ticket_history(lineage_id, ext_id, nonce, action, actor, principal, created_at)
+        select _lineage_id, ext_id, nonce, action, actor, principal, created_at
+        from ticket_history
+        where lineage_id = _source_lineage_id
+        order by id;
+    end if;
+end;
+$$;
@@ -0,0 +1,4 @@
+drop index if exists lineages_labels_idx;
+
+alter table lineages
+    drop column if exists labels;
@@ -0,0 +1,4 @@
+alter table lineages
+    add column if not exists labels jsonb not null default '{}'::jsonb;
+
+create index if not exists lineages_labels_idx on lineages using gin (labels);